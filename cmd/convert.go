@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
+
+const (
+	convertName             = "convert"
+	convertShortDescription = "Convert an api model to another API version"
+	convertLongDescription  = "Converts an api model to another API version, reporting the fields the target API version cannot represent"
+)
+
+type convertCmd struct {
+	apimodelPath string
+	toVersion    string
+	outputPath   string
+
+	// derived
+	containerService *api.ContainerService
+	apiVersion       string
+}
+
+func newConvertCmd() *cobra.Command {
+	cc := convertCmd{}
+
+	convertCmd := &cobra.Command{
+		Use:   convertName,
+		Short: convertShortDescription,
+		Long:  convertLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cc.validate(cmd, args)
+			return cc.run()
+		},
+	}
+
+	f := convertCmd.Flags()
+	f.StringVar(&cc.apimodelPath, "api-model", "", "path to the api model to convert")
+	f.StringVar(&cc.toVersion, "to-version", "", "the API version to convert the api model to")
+	f.StringVar(&cc.outputPath, "output", "", "path of the converted api model, written as YAML for a .yaml or .yml file (printed if absent)")
+
+	return convertCmd
+}
+
+func (cc *convertCmd) validate(cmd *cobra.Command, args []string) {
+	var err error
+
+	if cc.apimodelPath == "" {
+		if len(args) == 1 {
+			cc.apimodelPath = args[0]
+		} else if len(args) > 1 {
+			cmd.Usage()
+			log.Fatalln("too many arguments were provided to 'convert'")
+		} else {
+			cmd.Usage()
+			log.Fatalln("--api-model was not supplied, nor was one specified as a positional argument")
+		}
+	}
+
+	if cc.toVersion == "" {
+		cmd.Usage()
+		log.Fatalln("--to-version must be specified")
+	}
+
+	if _, err = os.Stat(cc.apimodelPath); os.IsNotExist(err) {
+		log.Fatalf("specified api model does not exist (%s)", cc.apimodelPath)
+	}
+
+	cc.containerService, cc.apiVersion, err = api.LoadContainerServiceFromFile(cc.apimodelPath, !allowUnknownFields)
+	if errs, ok := err.(common.ValidationErrors); ok {
+		for _, e := range errs {
+			log.WithField("code", e.Code).Errorf("%s: %s", e.Path, e.Message)
+		}
+		log.Fatalf("the api model %s is invalid, %d errors were found", cc.apimodelPath, len(errs))
+	}
+	if err != nil {
+		log.Fatalf("error parsing the api model: %s", err.Error())
+	}
+}
+
+func (cc *convertCmd) run() error {
+	b, unsupported, err := api.ConvertContainerServiceVersion(cc.containerService, cc.apiVersion, cc.toVersion)
+	if err != nil {
+		return fmt.Errorf("error converting %s to apiVersion %s: %s", cc.apimodelPath, cc.toVersion, err.Error())
+	}
+	for _, e := range unsupported {
+		log.WithField("code", e.Code).Warnf("%s: %s", e.Path, e.Message)
+	}
+	if len(unsupported) > 0 {
+		log.Warnf("%d fields of %s cannot be represented in apiVersion %s", len(unsupported), cc.apimodelPath, cc.toVersion)
+	}
+
+	if cc.outputPath == "" {
+		_, err = os.Stdout.Write(append(b, '\n'))
+		return err
+	}
+
+	if api.IsYAMLFile(cc.outputPath) {
+		if b, err = yaml.JSONToYAML(b); err != nil {
+			return fmt.Errorf("error converting the api model to YAML: %s", err.Error())
+		}
+	}
+	if err = ioutil.WriteFile(cc.outputPath, b, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %s", cc.outputPath, err.Error())
+	}
+	log.Infof("wrote %s", cc.outputPath)
+	return nil
+}
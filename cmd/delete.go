@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/operations"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	deleteName             = "delete"
+	deleteShortDescription = "delete a cluster"
+	deleteLongDescription  = "deletes the resource group of a cluster when acs-engine created it, otherwise only the resources of the cluster"
+)
+
+type deleteCmd struct {
+	authArgs
+
+	// user input
+	resourceGroupName   string
+	deploymentDirectory string
+	dryRun              bool
+	timeout             time.Duration
+
+	// derived
+	containerService *api.ContainerService
+	client           armhelpers.ACSEngineClient
+}
+
+func newDeleteCmd() *cobra.Command {
+	dc := deleteCmd{}
+
+	deleteCmd := &cobra.Command{
+		Use:   deleteName,
+		Short: deleteShortDescription,
+		Long:  deleteLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dc.validate(cmd, args)
+			return dc.run()
+		},
+	}
+
+	f := deleteCmd.Flags()
+	f.StringVar(&dc.resourceGroupName, "resource-group", "", "the resource group where the cluster is deployed")
+	f.DurationVar(&dc.timeout, "timeout", 0, "abort the command once it has run this long, such as 30m (0 means no timeout)")
+	f.StringVar(&dc.deploymentDirectory, "deployment-dir", "", "the location of the output from `generate`")
+	f.BoolVar(&dc.dryRun, "dry-run", false, "only list what would be deleted")
+	addAuthFlags(&dc.authArgs, f)
+
+	return deleteCmd
+}
+
+func (dc *deleteCmd) validate(cmd *cobra.Command, args []string) {
+	var err error
+
+	if dc.resourceGroupName == "" {
+		cmd.Usage()
+		log.Fatal("--resource-group must be specified")
+	}
+
+	if dc.deploymentDirectory == "" {
+		cmd.Usage()
+		log.Fatal("--deployment-dir must be specified")
+	}
+
+	apiModelPath := deploymentAPIModelPath(dc.deploymentDirectory)
+	if _, err := os.Stat(apiModelPath); os.IsNotExist(err) {
+		log.Fatalf("specified api model does not exist (%s)", apiModelPath)
+	}
+
+	dc.containerService, _, err = api.LoadContainerServiceFromFile(apiModelPath, !allowUnknownFields)
+	if err != nil {
+		log.Fatalf("error parsing the api model: %s", err.Error())
+	}
+
+	dc.client, err = dc.authArgs.getClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err.Error())
+	}
+}
+
+func (dc *deleteCmd) run() error {
+	clusterID := acsengine.GenerateClusterID(dc.containerService.Properties)
+	log.Infof("deleting cluster %s from resource group %s...", clusterID, dc.resourceGroupName)
+
+	ctx, cancel := newContext(dc.timeout)
+	defer cancel()
+
+	report, err := operations.DeleteCluster(ctx, newLogger(), dc.client, dc.resourceGroupName, dc.containerService.Properties, dc.dryRun)
+	if report != nil {
+		fmt.Print(report.String())
+	}
+	return err
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Azure/acs-engine/pkg/acsengine"
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/operations"
 )
 
 const (
@@ -33,6 +34,7 @@ type deployCmd struct {
 	classicMode       bool
 	noPrettyPrint     bool
 	parametersOnly    bool
+	apimodelYAML      bool
 
 	// derived
 	containerService *api.ContainerService
@@ -44,6 +46,9 @@ type deployCmd struct {
 	resourceGroup string
 	random        *rand.Rand
 	location      string
+	validateOnly  bool
+	skipQuota     bool
+	timeout       time.Duration
 }
 
 func newDeployCmd() *cobra.Command {
@@ -64,9 +69,14 @@ func newDeployCmd() *cobra.Command {
 	f.StringVar(&dc.outputDirectory, "output-directory", "", "output directory (derived from FQDN if absent)")
 	f.StringVar(&dc.caCertificatePath, "ca-certificate-path", "", "path to the CA certificate to use for Kubernetes PKI assets")
 	f.StringVar(&dc.caPrivateKeyPath, "ca-private-key-path", "", "path to the CA private key to use for Kubernetes PKI assets")
+	f.BoolVar(&dc.apimodelYAML, "apimodel-yaml", false, "also write the api model as apimodel.yaml (implied when --api-model is a YAML file)")
 	f.BoolVar(&dc.deploy, "deploy", false, "deploy as well")
 	f.StringVar(&dc.resourceGroup, "resource-group", "", "resource group to deploy to")
 	f.StringVar(&dc.location, "location", "", "location to deploy to")
+	f.BoolVar(&dc.validateOnly, "validate-only", false, "only validate the template against ARM in an existing resource group, without changing the subscription")
+
+	f.BoolVar(&dc.skipQuota, "skip-quota-check", false, "skip checking the regional core quotas and VM sizes before deploying")
+	f.DurationVar(&dc.timeout, "timeout", 0, "abort the command once it has run this long, such as 30m (0 means no timeout)")
 
 	addAuthFlags(&dc.authArgs, f)
 
@@ -94,7 +104,7 @@ func (dc *deployCmd) validate(cmd *cobra.Command, args []string) {
 		log.Fatalf("specified api model does not exist (%s)", dc.apimodelPath)
 	}
 
-	dc.containerService, dc.apiVersion, err = api.LoadContainerServiceFromFile(dc.apimodelPath)
+	dc.containerService, dc.apiVersion, err = api.LoadContainerServiceFromFile(dc.apimodelPath, !allowUnknownFields)
 	if err != nil {
 		log.Fatalf("error parsing the api model: %s", err.Error())
 	}
@@ -107,7 +117,7 @@ func (dc *deployCmd) validate(cmd *cobra.Command, args []string) {
 		dnsPrefix := dc.containerService.Properties.MasterProfile.DNSPrefix
 		log.Warnf("--resource-group was not specified. Using the DNS prefix from the apimodel as the resource group name: %s")
 		dc.resourceGroup = dnsPrefix
-		if dc.location == "" {
+		if dc.location == "" && !dc.validateOnly {
 			// TODO: move this so we only require location for a non-pre-existing RG?
 			log.Fatal("--resource-group was not specified. --location must be specified in case the resource group needs creation.")
 		}
@@ -131,32 +141,25 @@ func (dc *deployCmd) validate(cmd *cobra.Command, args []string) {
 func (dc *deployCmd) run() error {
 	templateGenerator, err := acsengine.InitializeTemplateGenerator(dc.classicMode)
 	if err != nil {
-		log.Fatalln("failed to initialize template generator: %s", err.Error())
+		return fmt.Errorf("failed to initialize template generator: %s", err.Error())
 	}
 
 	certsgenerated := false
 	template, parameters, certsgenerated, err := templateGenerator.GenerateTemplate(dc.containerService)
 	if err != nil {
-		log.Fatalf("error generating template %s: %s", dc.apimodelPath, err.Error())
-		os.Exit(1)
+		return fmt.Errorf("error generating template %s: %s", dc.apimodelPath, err.Error())
 	}
 
 	if template, err = acsengine.PrettyPrintArmTemplate(template); err != nil {
-		log.Fatalf("error pretty printing template: %s \n", err.Error())
+		return fmt.Errorf("error pretty printing template: %s", err.Error())
 	}
 	if parameters, err = acsengine.PrettyPrintJSON(parameters); err != nil {
-		log.Fatalf("error pretty printing template parameters: %s \n", err.Error())
+		return fmt.Errorf("error pretty printing template parameters: %s", err.Error())
 	}
 
-	if err = acsengine.WriteArtifacts(dc.containerService, dc.apiVersion, template, parameters, dc.outputDirectory, certsgenerated, dc.parametersOnly); err != nil {
-		log.Fatalf("error writing artifacts: %s \n", err.Error())
-	}
-
-	log.Infoln("deploying...")
-
-	_, err = dc.client.EnsureResourceGroup(dc.resourceGroup, dc.location)
-	if err != nil {
-		log.Fatalln(err)
+	if err = acsengine.WriteArtifacts(dc.containerService, dc.apiVersion, template, parameters, dc.outputDirectory, certsgenerated, dc.parametersOnly,
+		dc.apimodelYAML || api.IsYAMLFile(dc.apimodelPath)); err != nil {
+		return fmt.Errorf("error writing artifacts: %s", err.Error())
 	}
 
 	templateJSON := make(map[string]interface{})
@@ -164,25 +167,97 @@ func (dc *deployCmd) run() error {
 
 	err = json.Unmarshal([]byte(template), &templateJSON)
 	if err != nil {
-		log.Fatalln(err)
+		return err
 	}
 
 	err = json.Unmarshal([]byte(parameters), &parametersJSON)
 	if err != nil {
-		log.Fatalln(err)
+		return err
 	}
 
 	deploymentSuffix := dc.random.Int31()
+	deploymentName := fmt.Sprintf("%s-%d", dc.resourceGroup, deploymentSuffix)
+
+	if dc.validateOnly {
+		// nothing is changed in the subscription, ARM validates the template in an existing resource group
+		if _, err = dc.client.GetResourceGroup(dc.resourceGroup); err != nil {
+			return fmt.Errorf("--validate-only requires the resource group %s to exist: %s", dc.resourceGroup, err.Error())
+		}
+		if err = dc.validateTemplate(deploymentName, templateJSON, parametersJSON); err != nil {
+			return err
+		}
+		log.Infoln("the template is valid")
+		return nil
+	}
+
+	ctx, cancel := newContext(dc.timeout)
+	defer cancel()
+
+	if err = operations.EnsureResourceProviders(ctx, newLogger(), dc.client, dc.containerService.Properties, armhelpers.DefaultProviderRegistrationTimeout); err != nil {
+		return err
+	}
+
+	if !dc.skipQuota {
+		if err = dc.checkQuota(); err != nil {
+			return err
+		}
+	}
+
+	log.Infoln("deploying...")
+
+	// the tags let delete remove the resource group with the cluster, when deploy created it
+	clusterID := acsengine.GenerateClusterID(dc.containerService.Properties)
+	_, err = dc.client.EnsureResourceGroup(dc.resourceGroup, dc.location, operations.ResourceGroupTags(clusterID))
+	if err != nil {
+		return err
+	}
+
+	// catch invalid parameters and quota errors before any resource is deployed
+	if err = dc.validateTemplate(deploymentName, templateJSON, parametersJSON); err != nil {
+		return err
+	}
 
 	_, err = dc.client.DeployTemplate(
+		ctx,
 		dc.resourceGroup,
-		fmt.Sprintf("%s-%d", dc.resourceGroup, deploymentSuffix),
+		deploymentName,
 		templateJSON,
-		parametersJSON,
-		nil)
+		parametersJSON)
 	if err != nil {
-		log.Fatalln(err)
+		return err
 	}
 
 	return nil
 }
+
+// checkQuota fails the deployment early when the cluster does not fit in the location
+func (dc *deployCmd) checkQuota() error {
+	location := dc.containerService.Location
+	if location == "" {
+		location = dc.location
+	}
+	if location == "" {
+		log.Warnln("the location of the cluster is unknown, skipping the quota check")
+		return nil
+	}
+	return checkQuota(dc.client, dc.containerService, location)
+}
+
+// validateTemplate validates the template and parameters against ARM, and logs every validation error
+func (dc *deployCmd) validateTemplate(deploymentName string, templateJSON, parametersJSON map[string]interface{}) error {
+	log.Infoln("validating the template...")
+
+	result, err := dc.client.ValidateTemplate(dc.resourceGroup, deploymentName, templateJSON, parametersJSON)
+	if err != nil {
+		return fmt.Errorf("error validating the template: %s", err.Error())
+	}
+
+	validationErrors := armhelpers.ValidationErrors(result)
+	if len(validationErrors) == 0 {
+		return nil
+	}
+	for _, validationError := range validationErrors {
+		log.Errorln(validationError)
+	}
+	return fmt.Errorf("the template failed validation with %d error(s)", len(validationErrors))
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"path"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/Azure/acs-engine/pkg/acsengine"
 	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
 	"io/ioutil"
 )
 
@@ -26,6 +28,7 @@ type generateCmd struct {
 	classicMode       bool
 	noPrettyPrint     bool
 	parametersOnly    bool
+	apimodelYAML      bool
 
 	// derived
 	containerService *api.ContainerService
@@ -53,6 +56,7 @@ func newGenerateCmd() *cobra.Command {
 	f.BoolVar(&gc.classicMode, "classic-mode", false, "enable classic parameters and outputs")
 	f.BoolVar(&gc.noPrettyPrint, "no-pretty-print", false, "skip pretty printing the output")
 	f.BoolVar(&gc.parametersOnly, "parameters-only", false, "only output parameters files")
+	f.BoolVar(&gc.apimodelYAML, "apimodel-yaml", false, "also write the api model as apimodel.yaml (implied when --api-model is a YAML file)")
 
 	return generateCmd
 }
@@ -78,7 +82,13 @@ func (gc *generateCmd) validate(cmd *cobra.Command, args []string) {
 		log.Fatalf("specified api model does not exist (%s)", gc.apimodelPath)
 	}
 
-	gc.containerService, gc.apiVersion, err = api.LoadContainerServiceFromFile(gc.apimodelPath)
+	gc.containerService, gc.apiVersion, err = api.LoadContainerServiceFromFile(gc.apimodelPath, !allowUnknownFields)
+	if errs, ok := err.(common.ValidationErrors); ok {
+		for _, e := range errs {
+			log.WithField("code", e.Code).Errorf("%s: %s", e.Path, e.Message)
+		}
+		log.Fatalf("the api model %s is invalid, %d errors were found", gc.apimodelPath, len(errs))
+	}
 	if err != nil {
 		log.Fatalf("error parsing the api model: %s", err.Error())
 	}
@@ -114,27 +124,27 @@ func (gc *generateCmd) run() error {
 
 	templateGenerator, err := acsengine.InitializeTemplateGenerator(gc.classicMode)
 	if err != nil {
-		log.Fatalln("failed to initialize template generator: %s", err.Error())
+		return fmt.Errorf("failed to initialize template generator: %s", err.Error())
 	}
 
 	certsGenerated := false
 	template, parameters, certsGenerated, err := templateGenerator.GenerateTemplate(gc.containerService)
 	if err != nil {
-		log.Fatalf("error generating template %s: %s", gc.apimodelPath, err.Error())
-		os.Exit(1)
+		return fmt.Errorf("error generating template %s: %s", gc.apimodelPath, err.Error())
 	}
 
 	if !gc.noPrettyPrint {
 		if template, err = acsengine.PrettyPrintArmTemplate(template); err != nil {
-			log.Fatalf("error pretty printing template: %s \n", err.Error())
+			return fmt.Errorf("error pretty printing template: %s", err.Error())
 		}
 		if parameters, err = acsengine.PrettyPrintJSON(parameters); err != nil {
-			log.Fatalf("error pretty printing template parameters: %s \n", err.Error())
+			return fmt.Errorf("error pretty printing template parameters: %s", err.Error())
 		}
 	}
 
-	if err = acsengine.WriteArtifacts(gc.containerService, gc.apiVersion, template, parameters, gc.outputDirectory, certsGenerated, gc.parametersOnly); err != nil {
-		log.Fatalf("error writing artifacts: %s \n", err.Error())
+	if err = acsengine.WriteArtifacts(gc.containerService, gc.apiVersion, template, parameters, gc.outputDirectory, certsGenerated, gc.parametersOnly,
+		gc.apimodelYAML || api.IsYAMLFile(gc.apimodelPath)); err != nil {
+		return fmt.Errorf("error writing artifacts: %s", err.Error())
 	}
 
 	return nil
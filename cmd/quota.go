@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/operations"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	quotaName             = "check-quota"
+	quotaShortDescription = "check the quota and VM sizes of a cluster in a region"
+	quotaLongDescription  = "checks that the VM sizes of a cluster are available in a region, and that the regional core quotas of the subscription can accommodate it"
+)
+
+type quotaCmd struct {
+	authArgs
+
+	apimodelPath string
+	location     string
+
+	// derived
+	containerService *api.ContainerService
+	client           armhelpers.ACSEngineClient
+}
+
+func newQuotaCmd() *cobra.Command {
+	qc := quotaCmd{}
+
+	quotaCmd := &cobra.Command{
+		Use:   quotaName,
+		Short: quotaShortDescription,
+		Long:  quotaLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			qc.validate(cmd, args)
+			return qc.run()
+		},
+	}
+
+	f := quotaCmd.Flags()
+	f.StringVar(&qc.apimodelPath, "api-model", "", "")
+	f.StringVar(&qc.location, "location", "", "location to check (required if the api model does not specify it)")
+	addAuthFlags(&qc.authArgs, f)
+
+	return quotaCmd
+}
+
+func (qc *quotaCmd) validate(cmd *cobra.Command, args []string) {
+	var err error
+
+	if qc.apimodelPath == "" {
+		if len(args) == 1 {
+			qc.apimodelPath = args[0]
+		} else {
+			cmd.Usage()
+			log.Fatalln("--api-model was not supplied, nor was one specified as a positional argument")
+		}
+	}
+
+	if _, err := os.Stat(qc.apimodelPath); os.IsNotExist(err) {
+		log.Fatalf("specified api model does not exist (%s)", qc.apimodelPath)
+	}
+
+	qc.containerService, _, err = api.LoadContainerServiceFromFile(qc.apimodelPath, !allowUnknownFields)
+	if err != nil {
+		log.Fatalf("error parsing the api model: %s", err.Error())
+	}
+
+	if qc.containerService.Location != "" {
+		qc.location = qc.containerService.Location
+	}
+	if qc.location == "" {
+		cmd.Usage()
+		log.Fatal("--location must be specified when the api model does not specify a location")
+	}
+
+	qc.client, err = qc.authArgs.getClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err.Error())
+	}
+}
+
+func (qc *quotaCmd) run() error {
+	return checkQuota(qc.client, qc.containerService, qc.location)
+}
+
+// checkQuota prints the quota report of the cluster in the location, and returns an error if the cluster does not fit
+func checkQuota(client armhelpers.ACSEngineClient, cs *api.ContainerService, location string) error {
+	log.Infof("checking the quota and VM sizes in %s...", location)
+
+	report, err := operations.CheckQuota(client, cs.Properties, location)
+	if err != nil {
+		return err
+	}
+	fmt.Print(report.String())
+	return report.Err()
+}
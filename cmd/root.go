@@ -1,11 +1,21 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"path"
+	"syscall"
+	"time"
 
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
 	log "github.com/Sirupsen/logrus"
 	"github.com/satori/go.uuid"
 	"github.com/spf13/cobra"
@@ -19,7 +29,8 @@ const (
 )
 
 var (
-	debug bool
+	debug              bool
+	allowUnknownFields bool
 )
 
 // NewRootCmd returns the root command for ACS-Engine.
@@ -28,6 +39,9 @@ func NewRootCmd() *cobra.Command {
 		Use:   rootName,
 		Short: rootShortDescription,
 		Long:  rootLongDescription,
+		// the usage is only printed for invalid arguments, which validate reports, and main logs the errors
+		SilenceUsage:  true,
+		SilenceErrors: true,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			if debug {
 				log.SetLevel(log.DebugLevel)
@@ -35,20 +49,75 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		cmd.Usage()
+		return err
+	})
+
 	p := rootCmd.PersistentFlags()
 	p.BoolVar(&debug, "debug", false, "enable verbose debug logs")
+	p.BoolVar(&allowUnknownFields, "allow-unknown-fields", false, "ignore the fields of the api model files unknown to their API version instead of rejecting them")
 
 	rootCmd.AddCommand(newVersionCmd())
 	rootCmd.AddCommand(newGenerateCmd())
+	rootCmd.AddCommand(newSchemaCmd())
+	rootCmd.AddCommand(newConvertCmd())
 
 	if val := os.Getenv("ACSENGINE_EXPERIMENTAL_FEATURES"); val == "1" {
 		rootCmd.AddCommand(newUpgradeCmd())
 		rootCmd.AddCommand(newDeployCmd())
+		rootCmd.AddCommand(newScaleCmd())
+		rootCmd.AddCommand(newQuotaCmd())
+		rootCmd.AddCommand(newDeleteCmd())
+		rootCmd.AddCommand(newStatusCmd())
 	}
 
 	return rootCmd
 }
 
+// newContext returns the context of the operations of a command, done once the timeout expires,
+// if not zero, or once the command is interrupted. A second interrupt exits immediately.
+func newContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		log.Warnln("interrupted, canceling the running operations (interrupt again to exit immediately)")
+		cancel()
+		<-signals
+		os.Exit(1)
+	}()
+
+	return ctx, cancel
+}
+
+// deploymentAPIModelPath returns the path of the api model in a deployment directory, the output of generate.
+// apimodel.yaml is used when there is no apimodel.json.
+func deploymentAPIModelPath(deploymentDirectory string) string {
+	apiModelPath := path.Join(deploymentDirectory, "apimodel.json")
+	if _, err := os.Stat(apiModelPath); os.IsNotExist(err) {
+		for _, yamlFile := range []string{"apimodel.yaml", "apimodel.yml"} {
+			if _, err := os.Stat(path.Join(deploymentDirectory, yamlFile)); err == nil {
+				return path.Join(deploymentDirectory, yamlFile)
+			}
+		}
+	}
+	return apiModelPath
+}
+
+// newLogger returns the logger the commands pass to the operations they run
+func newLogger() *log.Entry {
+	return log.NewEntry(log.StandardLogger())
+}
+
 type authArgs struct {
 	RawAzureEnvironment string
 	rawSubscriptionID   string
@@ -98,14 +167,36 @@ func (authArgs *authArgs) getClient() (*armhelpers.AzureClient, error) {
 
 	switch authArgs.AuthMethod {
 	case "device":
-		return armhelpers.NewAzureClientWithDeviceAuth(env, authArgs.SubscriptionID.String())
+		return armhelpers.NewAzureClientWithDeviceAuth(newLogger(), env, authArgs.SubscriptionID.String())
 	case "client_secret":
-		return armhelpers.NewAzureClientWithClientSecret(env, authArgs.SubscriptionID.String(), authArgs.ClientID.String(), authArgs.ClientSecret)
+		return armhelpers.NewAzureClientWithClientSecret(newLogger(), env, authArgs.SubscriptionID.String(), authArgs.ClientID.String(), authArgs.ClientSecret)
 	case "client_certificate":
-		return armhelpers.NewAzureClientWithClientCertificate(env, authArgs.SubscriptionID.String(), authArgs.ClientID.String(), authArgs.CertificatePath, authArgs.PrivateKeyPath)
+		return armhelpers.NewAzureClientWithClientCertificate(newLogger(), env, authArgs.SubscriptionID.String(), authArgs.ClientID.String(), authArgs.CertificatePath, authArgs.PrivateKeyPath)
 	default:
 		log.Fatalf("--auth-method: ERROR: method unsupported. method=%q.", authArgs.AuthMethod)
 	}
 
 	return nil, nil // unreachable
 }
+
+// getKubernetesClient returns a client for the API server of the cluster, authenticated with the
+// admin certificates of the api model. location is used when the api model does not specify one,
+// otherwise the location of the resource group of the cluster is used.
+func getKubernetesClient(client armhelpers.ACSEngineClient, cs *api.ContainerService, resourceGroup, location string) (kubernetes.Client, error) {
+	if cs.Location != "" {
+		location = cs.Location
+	}
+	if location == "" {
+		group, err := client.GetResourceGroup(resourceGroup)
+		if err != nil {
+			return nil, fmt.Errorf("error getting the location of resource group %s: %s", resourceGroup, err.Error())
+		}
+		location = to.String(group.Location)
+	}
+
+	kubeConfig, err := acsengine.GenerateKubeConfig(cs.Properties, location)
+	if err != nil {
+		return nil, fmt.Errorf("error generating kubeconfig: %s", err.Error())
+	}
+	return kubernetes.NewClient(kubeConfig, time.Minute)
+}
@@ -0,0 +1,337 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+	"github.com/Azure/acs-engine/pkg/operations"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	scaleName             = "scale"
+	scaleShortDescription = "scales an agent pool of an existing cluster"
+	scaleLongDescription  = "scales an agent pool of an existing cluster up or down to the specified number of nodes"
+)
+
+type scaleCmd struct {
+	authArgs
+
+	// user input
+	resourceGroupName    string
+	deploymentDirectory  string
+	agentPoolToScale     string
+	newDesiredAgentCount int
+	location             string
+	drainTimeout         time.Duration
+	gracePeriod          time.Duration
+	timeout              time.Duration
+
+	// derived
+	containerService *api.ContainerService
+	apiVersion       string
+	apiModelPath     string
+	agentPool        *api.AgentPoolProfile
+	agentPoolIndex   int
+	client           armhelpers.ACSEngineClient
+	kubernetesClient kubernetes.Client
+	random           *rand.Rand
+}
+
+// NewScaleCmd run a command to scale an agent pool of a cluster
+func newScaleCmd() *cobra.Command {
+	sc := scaleCmd{}
+
+	scaleCmd := &cobra.Command{
+		Use:   scaleName,
+		Short: scaleShortDescription,
+		Long:  scaleLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sc.validate(cmd, args)
+			return sc.run()
+		},
+	}
+
+	f := scaleCmd.Flags()
+	f.StringVar(&sc.resourceGroupName, "resource-group", "", "the resource group where the cluster is deployed")
+	f.StringVar(&sc.deploymentDirectory, "deployment-dir", "", "the location of the output from `generate`")
+	f.StringVar(&sc.agentPoolToScale, "pool", "", "the name of the agent pool to scale")
+	f.IntVar(&sc.newDesiredAgentCount, "new-count", 0, "the desired number of nodes in the agent pool")
+	f.StringVar(&sc.location, "location", "", "location the cluster is deployed to (the location of the resource group if the api model does not specify it)")
+	f.DurationVar(&sc.drainTimeout, "drain-timeout", operations.DefaultDrainTimeout, "how long the pods of each removed node have to be evicted before scaling down is aborted")
+	f.DurationVar(&sc.gracePeriod, "drain-grace-period", 0, "termination grace period of the evicted pods (0 uses the grace period of each pod)")
+	f.DurationVar(&sc.timeout, "timeout", 0, "abort the command once it has run this long, such as 30m (0 means no timeout)")
+	addAuthFlags(&sc.authArgs, f)
+
+	return scaleCmd
+}
+
+func (sc *scaleCmd) validate(cmd *cobra.Command, args []string) {
+	log.Infoln("validating...")
+
+	var err error
+
+	if sc.resourceGroupName == "" {
+		cmd.Usage()
+		log.Fatal("--resource-group must be specified")
+	}
+
+	if sc.deploymentDirectory == "" {
+		cmd.Usage()
+		log.Fatal("--deployment-dir must be specified")
+	}
+
+	if sc.agentPoolToScale == "" {
+		cmd.Usage()
+		log.Fatal("--pool must be specified")
+	}
+
+	if sc.newDesiredAgentCount < api.MinAgentCount || sc.newDesiredAgentCount > api.MaxAgentCount {
+		cmd.Usage()
+		log.Fatalf("--new-count must be between %d and %d", api.MinAgentCount, api.MaxAgentCount)
+	}
+
+	// load apimodel from the deployment directory
+	sc.apiModelPath = deploymentAPIModelPath(sc.deploymentDirectory)
+
+	if _, err := os.Stat(sc.apiModelPath); os.IsNotExist(err) {
+		log.Fatalf("specified api model does not exist (%s)", sc.apiModelPath)
+	}
+
+	sc.containerService, sc.apiVersion, err = api.LoadContainerServiceFromFile(sc.apiModelPath, !allowUnknownFields)
+	if err != nil {
+		log.Fatalf("error parsing the api model: %s", err.Error())
+	}
+
+	sc.agentPoolIndex = -1
+	for i, pool := range sc.containerService.Properties.AgentPoolProfiles {
+		if strings.EqualFold(pool.Name, sc.agentPoolToScale) {
+			sc.agentPool = pool
+			sc.agentPoolIndex = i
+			break
+		}
+	}
+	if sc.agentPool == nil {
+		log.Fatalf("agent pool %q does not exist in the api model", sc.agentPoolToScale)
+	}
+
+	if sc.agentPool.IsAvailabilitySets() && !sc.containerService.Properties.OrchestratorProfile.IsKubernetes() {
+		log.Fatalf("scaling availability set agent pools is only supported for Kubernetes")
+	}
+
+	sc.client, err = sc.authArgs.getClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err.Error())
+	}
+
+	if sc.drainTimeout <= 0 {
+		cmd.Usage()
+		log.Fatal("--drain-timeout must be positive")
+	}
+
+	if sc.gracePeriod < 0 {
+		cmd.Usage()
+		log.Fatal("--drain-grace-period must not be negative")
+	}
+
+	sc.random = rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+func (sc *scaleCmd) run() error {
+	ctx, cancel := newContext(sc.timeout)
+	defer cancel()
+
+	currentCount := sc.agentPool.Count
+	nextIndex := currentCount
+	var poolVMs []compute.VirtualMachine
+
+	if sc.agentPool.IsAvailabilitySets() {
+		var err error
+		if poolVMs, nextIndex, err = sc.getAgentPoolVMs(); err != nil {
+			return fmt.Errorf("failed to list the VMs of agent pool %q: %s", sc.agentPool.Name, err.Error())
+		}
+		if len(poolVMs) != currentCount {
+			log.Warnf("the api model has %d nodes in agent pool %q but %d were found in resource group %q",
+				currentCount, sc.agentPool.Name, len(poolVMs), sc.resourceGroupName)
+			currentCount = len(poolVMs)
+		}
+	}
+
+	if currentCount == sc.newDesiredAgentCount {
+		log.Infof("agent pool %q already has %d nodes, nothing to do", sc.agentPool.Name, currentCount)
+		return nil
+	}
+
+	if sc.newDesiredAgentCount < currentCount && sc.agentPool.IsAvailabilitySets() {
+		// Sort by VM Name (e.g.: k8s-agentpool1-22551669-3) offset no. in descending order
+		// so that the nodes with the highest offsets are removed first
+		sort.Sort(sort.Reverse(armhelpers.ByVMNameOffset(poolVMs)))
+
+		// the Kubernetes client lets scaling down drain each node before deleting it
+		var err error
+		if sc.kubernetesClient, err = getKubernetesClient(sc.client, sc.containerService, sc.resourceGroupName, sc.location); err != nil {
+			return fmt.Errorf("failed to get Kubernetes client: %s", err.Error())
+		}
+
+		for _, vm := range poolVMs[:currentCount-sc.newDesiredAgentCount] {
+			if err := operations.DrainNode(ctx, newLogger(), sc.kubernetesClient, *vm.Name, sc.gracePeriod, sc.drainTimeout); err != nil {
+				return fmt.Errorf("failed to drain node %s: %s", *vm.Name, err.Error())
+			}
+			log.Infof("removing VM: %s", *vm.Name)
+			if err := operations.CleanDeleteVirtualMachine(ctx, newLogger(), sc.client, sc.resourceGroupName, *vm.Name); err != nil {
+				return fmt.Errorf("failed to delete VM %s: %s", *vm.Name, err.Error())
+			}
+		}
+	} else {
+		if err := sc.deployScaledTemplate(ctx, currentCount, nextIndex); err != nil {
+			return err
+		}
+	}
+
+	sc.agentPool.Count = sc.newDesiredAgentCount
+	if err := sc.saveAPIModel(); err != nil {
+		return fmt.Errorf("error writing the api model: %s", err.Error())
+	}
+
+	return nil
+}
+
+// getAgentPoolVMs returns the VMs of the resource group that belong to the agent pool being scaled,
+// and the index following the highest node index of these VMs, where new nodes can be added
+func (sc *scaleCmd) getAgentPoolVMs() ([]compute.VirtualMachine, int, error) {
+	vmListResult, err := sc.client.ListVirtualMachines(sc.resourceGroupName)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	vms := []compute.VirtualMachine{}
+	nextIndex := 0
+	if vmListResult.Value == nil {
+		return vms, nextIndex, nil
+	}
+	for _, vm := range *vmListResult.Value {
+		if vm.Name == nil {
+			continue
+		}
+		clusterVM, ok := operations.IdentifyClusterVM(sc.containerService.Properties, vm)
+		if !ok || clusterVM.PoolName != sc.agentPool.Name {
+			continue
+		}
+		log.Infoln(fmt.Sprintf("Agent VM name: %s", *vm.Name))
+		vms = append(vms, vm)
+		if clusterVM.NodeIndex >= nextIndex {
+			nextIndex = clusterVM.NodeIndex + 1
+		}
+	}
+
+	return vms, nextIndex, nil
+}
+
+// deployScaledTemplate regenerates the template with the new agent pool count, strips
+// the resources that must not be redeployed on an existing cluster, and deploys it.
+// The new nodes of an availability set pool are created from nextIndex, after the highest
+// index in use, as the indexes of the existing nodes may have gaps.
+func (sc *scaleCmd) deployScaledTemplate(ctx context.Context, currentCount, nextIndex int) error {
+	sc.agentPool.Count = sc.newDesiredAgentCount
+	if sc.agentPool.IsAvailabilitySets() {
+		// the template deploys the nodes from the offset to the count of the pool
+		sc.agentPool.Count = nextIndex + sc.newDesiredAgentCount - currentCount
+	}
+
+	templateGenerator, err := acsengine.InitializeTemplateGenerator(false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize template generator: %s", err.Error())
+	}
+
+	templateJSON, parametersJSON, _, err := templateGenerator.GenerateTemplate(sc.containerService)
+	if err != nil {
+		return fmt.Errorf("error generating scale template: %s", err.Error())
+	}
+
+	templateMap := make(map[string]interface{})
+	parametersMap := make(map[string]interface{})
+	if err = json.Unmarshal([]byte(templateJSON), &templateMap); err != nil {
+		return err
+	}
+	if err = json.Unmarshal([]byte(parametersJSON), &parametersMap); err != nil {
+		return err
+	}
+
+	logger := newLogger()
+	agentPoolsToPreserve := map[string]bool{sc.agentPool.Name: true}
+	if sc.agentPool.IsAvailabilitySets() {
+		// only create the new nodes, the existing ones keep their offsets
+		parametersMap[fmt.Sprintf("%sOffset", sc.agentPool.Name)] = map[string]interface{}{
+			"value": nextIndex,
+		}
+		// like an agent upgrade, the masters and the VMs of the other agent pools must not be redeployed
+		if err = acsengine.NormalizeResourcesForK8sAgentUpgrade(logger, templateMap, agentPoolsToPreserve); err != nil {
+			return fmt.Errorf("error normalizing scale template: %s", err.Error())
+		}
+	} else {
+		if err = acsengine.NormalizeForVMSSScaling(logger, templateMap); err != nil {
+			return fmt.Errorf("error normalizing scale template: %s", err.Error())
+		}
+		if err = acsengine.RemoveOtherAgentPoolScaleSets(logger, templateMap, agentPoolsToPreserve); err != nil {
+			return fmt.Errorf("error normalizing scale template: %s", err.Error())
+		}
+	}
+
+	log.Infof("scaling agent pool %q from %d to %d nodes", sc.agentPool.Name, currentCount, sc.newDesiredAgentCount)
+
+	deploymentSuffix := sc.random.Int31()
+	_, err = sc.client.DeployTemplate(
+		ctx,
+		sc.resourceGroupName,
+		fmt.Sprintf("%s-%d", sc.resourceGroupName, deploymentSuffix),
+		templateMap,
+		parametersMap)
+
+	return err
+}
+
+// saveAPIModel writes the api model, with the new agent pool count, back to the deployment directory.
+// Both apimodel.json and apimodel.yaml are updated when generate wrote both.
+func (sc *scaleCmd) saveAPIModel() error {
+	apiModelPaths := []string{sc.apiModelPath}
+	for _, file := range []string{"apimodel.json", "apimodel.yaml", "apimodel.yml"} {
+		apiModelPath := path.Join(sc.deploymentDirectory, file)
+		if _, err := os.Stat(apiModelPath); err == nil && apiModelPath != sc.apiModelPath {
+			apiModelPaths = append(apiModelPaths, apiModelPath)
+		}
+	}
+
+	for _, apiModelPath := range apiModelPaths {
+		serialize := api.SerializeContainerService
+		if api.IsYAMLFile(apiModelPath) {
+			serialize = api.SerializeContainerServiceYAML
+		}
+		b, err := serialize(sc.containerService, sc.apiVersion)
+		if err != nil {
+			return err
+		}
+
+		if err = ioutil.WriteFile(apiModelPath, b, 0600); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "wrote %s\n", apiModelPath)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
+
+const (
+	schemaName             = "schema"
+	schemaShortDescription = "Print the JSON Schema of the api models"
+	schemaLongDescription  = "Prints the JSON Schema of the api model or the upgrade model of an API version, or writes the schemas of all the API versions to a directory, for editors and form generators"
+)
+
+type schemaCmd struct {
+	apiVersion      string
+	upgrade         bool
+	outputDirectory string
+}
+
+func newSchemaCmd() *cobra.Command {
+	sc := schemaCmd{}
+
+	schemaCmd := &cobra.Command{
+		Use:   schemaName,
+		Short: schemaShortDescription,
+		Long:  schemaLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sc.validate(cmd, args)
+			return sc.run()
+		},
+	}
+
+	f := schemaCmd.Flags()
+	f.StringVar(&sc.apiVersion, "api-version", "", "the API version of the schema to print")
+	f.BoolVar(&sc.upgrade, "upgrade", false, "print the schema of the upgrade model instead of the api model")
+	f.StringVar(&sc.outputDirectory, "output-directory", "", "write the schemas of all the API versions to this directory instead")
+
+	return schemaCmd
+}
+
+func (sc *schemaCmd) validate(cmd *cobra.Command, args []string) {
+	if len(args) > 0 {
+		cmd.Usage()
+		log.Fatalln("'schema' does not take positional arguments")
+	}
+	if sc.apiVersion == "" && sc.outputDirectory == "" {
+		cmd.Usage()
+		log.Fatalln("--api-version or --output-directory must be specified")
+	}
+	if sc.apiVersion != "" && sc.outputDirectory != "" {
+		cmd.Usage()
+		log.Fatalln("--api-version and --output-directory are mutually exclusive")
+	}
+}
+
+func (sc *schemaCmd) run() error {
+	if sc.outputDirectory == "" {
+		getSchema := api.GetJSONSchema
+		if sc.upgrade {
+			getSchema = api.GetUpgradeJSONSchema
+		}
+		schema, err := getSchema(sc.apiVersion)
+		if err != nil {
+			return err
+		}
+		b, err := marshalSchema(schema)
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+
+	if err := os.MkdirAll(sc.outputDirectory, 0755); err != nil {
+		return fmt.Errorf("error creating directory %s: %s", sc.outputDirectory, err.Error())
+	}
+	for _, version := range api.APIVersions {
+		if err := writeSchema(sc.outputDirectory, "apimodel", version, api.GetJSONSchema); err != nil {
+			return err
+		}
+	}
+	for _, version := range api.UpgradeAPIVersions {
+		if err := writeSchema(sc.outputDirectory, "upgrademodel", version, api.GetUpgradeJSONSchema); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeSchema writes the schema of an API version to <dir>/<model>.<version>.schema.json
+func writeSchema(dir, model, version string, getSchema func(string) (*common.Schema, error)) error {
+	schema, err := getSchema(version)
+	if err != nil {
+		return err
+	}
+	b, err := marshalSchema(schema)
+	if err != nil {
+		return err
+	}
+	file := path.Join(dir, fmt.Sprintf("%s.%s.schema.json", model, version))
+	if err := ioutil.WriteFile(file, b, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %s", file, err.Error())
+	}
+	log.Infof("wrote %s", file)
+	return nil
+}
+
+func marshalSchema(schema *common.Schema) ([]byte, error) {
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling the schema: %s", err.Error())
+	}
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/operations"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const (
+	statusName             = "status"
+	statusShortDescription = "report the status of the VMs of a cluster"
+	statusLongDescription  = "compares the VMs of a cluster to its api model: counts, VM sizes, provisioning and power states, and orchestrator versions"
+)
+
+// ErrUnhealthyCluster is returned by the status command when the VMs of the cluster do not match its api model
+var ErrUnhealthyCluster = errors.New("the cluster is not healthy")
+
+type statusCmd struct {
+	authArgs
+
+	// user input
+	resourceGroupName   string
+	deploymentDirectory string
+	output              string
+
+	// derived
+	containerService *api.ContainerService
+	client           armhelpers.ACSEngineClient
+}
+
+func newStatusCmd() *cobra.Command {
+	sc := statusCmd{}
+
+	statusCmd := &cobra.Command{
+		Use:   statusName,
+		Short: statusShortDescription,
+		Long:  statusLongDescription,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sc.validate(cmd, args)
+			return sc.run()
+		},
+	}
+
+	f := statusCmd.Flags()
+	f.StringVar(&sc.resourceGroupName, "resource-group", "", "the resource group where the cluster is deployed")
+	f.StringVar(&sc.deploymentDirectory, "deployment-dir", "", "the location of the output from `generate`")
+	f.StringVarP(&sc.output, "output", "o", "table", "output format (`table` or `json`)")
+	addAuthFlags(&sc.authArgs, f)
+
+	return statusCmd
+}
+
+func (sc *statusCmd) validate(cmd *cobra.Command, args []string) {
+	var err error
+
+	if sc.resourceGroupName == "" {
+		cmd.Usage()
+		log.Fatal("--resource-group must be specified")
+	}
+
+	if sc.deploymentDirectory == "" {
+		cmd.Usage()
+		log.Fatal("--deployment-dir must be specified")
+	}
+
+	if sc.output != "table" && sc.output != "json" {
+		cmd.Usage()
+		log.Fatalf("--output must be table or json, not %q", sc.output)
+	}
+
+	apiModelPath := deploymentAPIModelPath(sc.deploymentDirectory)
+	if _, err := os.Stat(apiModelPath); os.IsNotExist(err) {
+		log.Fatalf("specified api model does not exist (%s)", apiModelPath)
+	}
+
+	sc.containerService, _, err = api.LoadContainerServiceFromFile(apiModelPath, !allowUnknownFields)
+	if err != nil {
+		log.Fatalf("error parsing the api model: %s", err.Error())
+	}
+
+	sc.client, err = sc.authArgs.getClient()
+	if err != nil {
+		log.Fatalf("failed to get client: %s", err.Error())
+	}
+}
+
+func (sc *statusCmd) run() error {
+	status, err := operations.GetClusterStatus(sc.client, sc.containerService, sc.resourceGroupName)
+	if err != nil {
+		return err
+	}
+
+	if sc.output == "json" {
+		b, err := json.MarshalIndent(status, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error serializing the status: %s", err.Error())
+		}
+		fmt.Println(string(b))
+	} else {
+		fmt.Print(status.Table())
+	}
+
+	if !status.Healthy() {
+		return ErrUnhealthyCluster
+	}
+	return nil
+}
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"path"
+	"time"
 
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
 	"github.com/Azure/acs-engine/pkg/operations"
 
 	log "github.com/Sirupsen/logrus"
@@ -25,6 +28,12 @@ type upgradeCmd struct {
 	resourceGroupName   string
 	deploymentDirectory string
 	upgradeModelFile    string
+	resume              bool
+	location            string
+	validationTimeout   time.Duration
+	drainTimeout        time.Duration
+	gracePeriod         time.Duration
+	timeout             time.Duration
 	containerService    *api.ContainerService
 	apiVersion          string
 
@@ -32,6 +41,8 @@ type upgradeCmd struct {
 	upgradeContainerService *api.UpgradeContainerService
 	upgradeAPIVersion       string
 	client                  armhelpers.ACSEngineClient
+	checkpoint              *operations.UpgradeCheckpoint
+	kubernetesClient        kubernetes.Client
 }
 
 // NewUpgradeCmd run a command to upgrade a Kubernetes cluster
@@ -51,6 +62,12 @@ func newUpgradeCmd() *cobra.Command {
 	f.StringVar(&uc.resourceGroupName, "resource-group", "", "the resource group where the cluster is deployed")
 	f.StringVar(&uc.deploymentDirectory, "deployment-dir", "", "the location of the output from `generate`")
 	f.StringVar(&uc.upgradeModelFile, "upgrademodel-file", "", "file path to upgrade API model")
+	f.BoolVar(&uc.resume, "resume", false, "resume an interrupted upgrade from the checkpoint in the deployment directory")
+	f.StringVar(&uc.location, "location", "", "location the cluster is deployed to (the location of the resource group if the api model does not specify it)")
+	f.DurationVar(&uc.drainTimeout, "drain-timeout", operations.DefaultDrainTimeout, "how long the pods of each agent node have to be evicted before the upgrade is aborted")
+	f.DurationVar(&uc.gracePeriod, "drain-grace-period", 0, "termination grace period of the evicted pods (0 uses the grace period of each pod)")
+	f.DurationVar(&uc.validationTimeout, "node-validation-timeout", operations.DefaultNodeValidationTimeout, "how long each upgraded node has to become healthy before the upgrade is aborted")
+	f.DurationVar(&uc.timeout, "timeout", 0, "abort the command once it has run this long, such as 30m (0 means no timeout)")
 	addAuthFlags(&uc.authArgs, f)
 
 	return upgradeCmd
@@ -82,13 +99,13 @@ func (uc *upgradeCmd) validate(cmd *cobra.Command, args []string) {
 	}
 
 	// load apimodel from the deployment directory
-	apiModelPath := path.Join(uc.deploymentDirectory, "apimodel.json")
+	apiModelPath := deploymentAPIModelPath(uc.deploymentDirectory)
 
 	if _, err := os.Stat(apiModelPath); os.IsNotExist(err) {
 		log.Fatalf("specified api model does not exist (%s)", apiModelPath)
 	}
 
-	uc.containerService, uc.apiVersion, err = api.LoadContainerServiceFromFile(apiModelPath)
+	uc.containerService, uc.apiVersion, err = api.LoadContainerServiceFromFile(apiModelPath, !allowUnknownFields)
 	if err != nil {
 		log.Fatalf("error parsing the api model: %s", err.Error())
 	}
@@ -97,16 +114,57 @@ func (uc *upgradeCmd) validate(cmd *cobra.Command, args []string) {
 		log.Fatalf("specified upgrade model file does not exist (%s)", uc.upgradeModelFile)
 	}
 
-	uc.upgradeContainerService, uc.upgradeAPIVersion, err = api.LoadUpgradeContainerServiceFromFile(uc.upgradeModelFile)
+	uc.upgradeContainerService, uc.upgradeAPIVersion, err = api.LoadUpgradeContainerServiceFromFile(uc.upgradeModelFile, !allowUnknownFields)
 	if err != nil {
 		log.Fatalf("error parsing the upgrade api model: %s", err.Error())
 	}
 
+	checkpointPath := path.Join(uc.deploymentDirectory, operations.UpgradeCheckpointFileName)
+	if uc.resume {
+		uc.checkpoint, err = operations.LoadUpgradeCheckpoint(checkpointPath)
+		if err != nil {
+			log.Fatalf("error loading the upgrade checkpoint: %s", err.Error())
+		}
+		if uc.checkpoint.FromVersion != uc.containerService.Properties.OrchestratorProfile.OrchestratorVersion ||
+			uc.checkpoint.TargetVersion != uc.upgradeContainerService.OrchestratorProfile.OrchestratorVersion {
+			log.Fatalf("the upgrade checkpoint (%s) is for an upgrade from %s to %s", checkpointPath,
+				uc.checkpoint.FromVersion, uc.checkpoint.TargetVersion)
+		}
+	} else {
+		if _, err := os.Stat(checkpointPath); err == nil {
+			log.Fatalf("an interrupted upgrade was found (%s), use --resume to continue it", checkpointPath)
+		}
+		uc.checkpoint = operations.NewUpgradeCheckpoint(checkpointPath,
+			uc.containerService.Properties.OrchestratorProfile.OrchestratorVersion,
+			uc.upgradeContainerService.OrchestratorProfile.OrchestratorVersion)
+	}
+
 	uc.client, err = uc.authArgs.getClient()
 	if err != nil {
 		log.Fatalf("failed to get client") // TODO: cleanup
 	}
 
+	if uc.validationTimeout <= 0 {
+		cmd.Usage()
+		log.Fatal("--node-validation-timeout must be positive")
+	}
+
+	if uc.drainTimeout <= 0 {
+		cmd.Usage()
+		log.Fatal("--drain-timeout must be positive")
+	}
+
+	if uc.gracePeriod < 0 {
+		cmd.Usage()
+		log.Fatal("--drain-grace-period must not be negative")
+	}
+
+	// the Kubernetes client lets the upgrade drain each node before deleting it,
+	// and check that its replacement joins the cluster
+	if uc.kubernetesClient, err = getKubernetesClient(uc.client, uc.containerService, uc.resourceGroupName, uc.location); err != nil {
+		log.Fatalf("failed to get Kubernetes client: %s", err.Error())
+	}
+
 	// TODO: Validate that downgrade is not allowed
 	// TODO: Validate noop case and return early
 }
@@ -115,12 +173,21 @@ func (uc *upgradeCmd) run(cmd *cobra.Command, args []string) error {
 	uc.validate(cmd, args)
 
 	upgradeCluster := operations.UpgradeCluster{
-		Client: uc.client,
+		Client:                uc.client,
+		Checkpoint:            uc.checkpoint,
+		KubernetesClient:      uc.kubernetesClient,
+		NodeValidationTimeout: uc.validationTimeout,
+		DrainTimeout:          uc.drainTimeout,
+		EvictionGracePeriod:   uc.gracePeriod,
+		Logger:                newLogger(),
 	}
 
-	if err := upgradeCluster.UpgradeCluster(uc.authArgs.SubscriptionID, uc.resourceGroupName,
+	ctx, cancel := newContext(uc.timeout)
+	defer cancel()
+
+	if err := upgradeCluster.UpgradeCluster(ctx, uc.authArgs.SubscriptionID, uc.resourceGroupName,
 		uc.containerService, uc.upgradeContainerService); err != nil {
-		log.Fatalf("Error upgrading cluster: %s \n", err.Error())
+		return fmt.Errorf("Error upgrading cluster: %s", err.Error())
 	}
 
 	return nil
@@ -1,12 +1,18 @@
 package main
 
 import (
+	"os"
+
 	"github.com/Azure/acs-engine/cmd"
 	log "github.com/Sirupsen/logrus"
 )
 
 func main() {
 	if err := cmd.NewRootCmd().Execute(); err != nil {
+		if err == cmd.ErrUnhealthyCluster {
+			// the status printed by the command already shows what is unhealthy
+			os.Exit(1)
+		}
 		log.Fatalln(err)
 	}
 }
@@ -125,7 +125,11 @@
       {
         "creationSource" : "[concat('acsengine-', variables('{{.Name}}VMNamePrefix'), copyIndex(variables('{{.Name}}Offset')))]",
         "resourceNameSuffix" : "[variables('nameSuffix')]",
-        "orchestrator" : "[variables('orchestratorNameVersionTag')]"
+        "orchestrator" : "[variables('orchestratorNameVersionTag')]",
+        "role" : "agent",
+        "poolName" : "{{.Name}}",
+        "clusterID" : "[variables('nameSuffix')]",
+        "nodeIndex" : "[string(copyIndex(variables('{{.Name}}Offset')))]"
       },
       "location": "[variables('location')]",
       "name": "[concat(variables('{{.Name}}VMNamePrefix'), copyIndex(variables('{{.Name}}Offset')))]",
@@ -268,6 +268,22 @@ func GenerateClusterID(properties *api.Properties) string {
 	return fmt.Sprintf("%08d", rand.Uint32())[:uniqueNameSuffixSize]
 }
 
+// GetK8sMasterVMNamePrefix returns the name prefix shared by the master VMs of a Kubernetes cluster
+func GetK8sMasterVMNamePrefix(properties *api.Properties) string {
+	return fmt.Sprintf("k8s-master-%s-", GenerateClusterID(properties))
+}
+
+// GetK8sAgentVMNamePrefix returns the name prefix shared by the VMs of the Kubernetes agent pool
+// at the specified index; the VM names are this prefix followed by the node offset
+func GetK8sAgentVMNamePrefix(properties *api.Properties, agentPoolIndex int) string {
+	clusterID := GenerateClusterID(properties)
+	agentPool := properties.AgentPoolProfiles[agentPoolIndex]
+	if agentPool.IsWindows() {
+		return fmt.Sprintf("%sacs%d", clusterID[:5], 900+agentPoolIndex)
+	}
+	return fmt.Sprintf("k8s-%s-%s-", agentPool.Name, clusterID)
+}
+
 // GenerateKubeConfig returns a JSON string representing the KubeConfig
 func GenerateKubeConfig(properties *api.Properties, location string) (string, error) {
 	b, err := Asset(kubeConfigJSON)
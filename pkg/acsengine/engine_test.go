@@ -2,8 +2,10 @@ package acsengine
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
 	"github.com/Azure/acs-engine/pkg/api/vlabs"
+	"github.com/Sirupsen/logrus"
 )
 
 const (
@@ -26,7 +29,7 @@ func TestExpected(t *testing.T) {
 	}
 
 	for _, tuple := range *apiModelTestFiles {
-		containerService, version, err := api.LoadContainerServiceFromFile(tuple.APIModelFilename)
+		containerService, version, err := api.LoadContainerServiceFromFile(tuple.APIModelFilename, true)
 		if err != nil {
 			t.Errorf("Loading file %s got error: %s", tuple.APIModelFilename, err.Error())
 			continue
@@ -122,7 +125,7 @@ func TestExpected(t *testing.T) {
 			if err != nil {
 				t.Error(err)
 			}
-			containerService, version, err = api.DeserializeContainerService(b)
+			containerService, version, err = api.DeserializeContainerService(b, true)
 			if err != nil {
 				t.Error(err)
 			}
@@ -138,6 +141,117 @@ func TestExpected(t *testing.T) {
 	}
 }
 
+func TestYAMLRoundTrip(t *testing.T) {
+	apiModelTestFiles := &[]APIModelTestFile{}
+	if e := IterateTestFilesDirectory(TestDataDir, apiModelTestFiles); e != nil {
+		t.Fatal(e.Error())
+	}
+
+	dir, err := ioutil.TempDir("", "acs-engine-yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	yamlFile := filepath.Join(dir, "apimodel.yaml")
+
+	for _, tuple := range *apiModelTestFiles {
+		containerService, version, err := api.LoadContainerServiceFromFile(tuple.APIModelFilename, true)
+		if err != nil {
+			t.Errorf("Loading file %s got error: %s", tuple.APIModelFilename, err.Error())
+			continue
+		}
+		expected, err := api.SerializeContainerService(containerService, version)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		b, err := api.SerializeContainerServiceYAML(containerService, version)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if err = ioutil.WriteFile(yamlFile, b, 0600); err != nil {
+			t.Fatal(err)
+		}
+		containerService, version, err = api.LoadContainerServiceFromFile(yamlFile, true)
+		if err != nil {
+			t.Errorf("Loading the YAML api model of %s got error: %s", tuple.APIModelFilename, err.Error())
+			continue
+		}
+		generated, err := api.SerializeContainerService(containerService, version)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+
+		if !bytes.Equal(expected, generated) {
+			t.Errorf("api model %s changed in the YAML round trip:\n%s", tuple.APIModelFilename, generated)
+		}
+	}
+}
+
+func TestNormalizeForAgentPoolScaling(t *testing.T) {
+	for _, test := range []struct {
+		apiModelFilename string
+		agentPool        string
+		resourceType     string
+		normalize        func(*logrus.Entry, map[string]interface{}, map[string]bool) error
+	}{
+		{"disks-managed/kubernetes-vmas.json", "agentpool1", vmResourceType, NormalizeResourcesForK8sAgentUpgrade},
+		{"disks-managed/swarm-vmss.json", "agent128", vmssResourceType, RemoveOtherAgentPoolScaleSets},
+	} {
+		templateMap := generateTestTemplate(t, filepath.Join(TestDataDir, test.apiModelFilename))
+		if err := test.normalize(logrus.NewEntry(logrus.New()), templateMap, map[string]bool{test.agentPool: true}); err != nil {
+			t.Fatalf("normalizing the template of %s got error: %s", test.apiModelFilename, err.Error())
+		}
+
+		found := 0
+		for _, resource := range templateMap[resourcesFieldName].([]interface{}) {
+			resourceMap := resource.(map[string]interface{})
+			if resourceMap[typeFieldName] != test.resourceType {
+				continue
+			}
+			name := resourceMap[nameFieldName].(string)
+			if !strings.Contains(name, fmt.Sprintf("variables('%sVMNamePrefix')", test.agentPool)) {
+				t.Errorf("the template of %s should not deploy %s", test.apiModelFilename, name)
+				continue
+			}
+			found++
+		}
+		if found != 1 {
+			t.Errorf("the template of %s should deploy the %s of agent pool %s, found %d", test.apiModelFilename, test.resourceType, test.agentPool, found)
+		}
+	}
+}
+
+func generateTestTemplate(t *testing.T, apiModelFilename string) map[string]interface{} {
+	containerService, _, err := api.LoadContainerServiceFromFile(apiModelFilename, true)
+	if err != nil {
+		t.Fatalf("Loading file %s got error: %s", apiModelFilename, err.Error())
+	}
+	if containerService.Properties.CertificateProfile == nil {
+		// avoid generating certificates
+		containerService.Properties.CertificateProfile = &api.CertificateProfile{}
+		addTestCertificateProfile(containerService.Properties.CertificateProfile)
+	}
+
+	templateGenerator, err := InitializeTemplateGenerator(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template, _, _, err := templateGenerator.GenerateTemplate(containerService)
+	if err != nil {
+		t.Fatalf("generating the template of %s got error: %s", apiModelFilename, err.Error())
+	}
+
+	templateMap := map[string]interface{}{}
+	if err = json.Unmarshal([]byte(template), &templateMap); err != nil {
+		t.Fatal(err)
+	}
+	return templateMap
+}
+
 // APIModelTestFile holds the test file name and knows how to find the expected files
 type APIModelTestFile struct {
 	APIModelFilename string
@@ -9,7 +9,9 @@ import (
 	"github.com/Azure/acs-engine/pkg/api"
 )
 
-func WriteArtifacts(containerService *api.ContainerService, apiVersion, template, parameters, artifactsDir string, certsGenerated bool, parametersOnly bool) error {
+// WriteArtifacts writes the api model, the template, its parameters and the generated certificates to artifactsDir.
+// The api model is also written as apimodel.yaml when apimodelYAML is true.
+func WriteArtifacts(containerService *api.ContainerService, apiVersion, template, parameters, artifactsDir string, certsGenerated bool, parametersOnly bool, apimodelYAML bool) error {
 	if len(artifactsDir) == 0 {
 		artifactsDir = fmt.Sprintf("%s-%s", containerService.Properties.OrchestratorProfile.OrchestratorType, GenerateClusterID(containerService.Properties))
 		artifactsDir = path.Join("_output", artifactsDir)
@@ -29,6 +31,15 @@ func WriteArtifacts(containerService *api.ContainerService, apiVersion, template
 			return e
 		}
 
+		if apimodelYAML {
+			if b, err = api.SerializeContainerServiceYAML(containerService, apiVersion); err != nil {
+				return err
+			}
+			if e := saveFile(artifactsDir, "apimodel.yaml", b); e != nil {
+				return e
+			}
+		}
+
 		if e := saveFileString(artifactsDir, "azuredeploy.json", template); e != nil {
 			return e
 		}
@@ -566,7 +566,7 @@ func kubernetesagentcustomdataYml() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\xde\x7c\x0a\x42\x28\xaa\x18\x50\xec\xed\xde\x5b\x81\x2b\x90\x4b\xd2\xd6\xe8\xba\x31\xea\x34\xf7\x90\xcd\x03\x2d\x8e\x25\x22\x12\xa9\x25\x29\x37\x59\xc1\xdf\xfd\x40\xea\x2f\x25\x2a\xf1\xb6\x4d\x36\xb7\xdb\xbe\xa4\x16\x87\xa3\xe1\x6f\xfe\x0f\x85\x10\x42\xc5\x01\x32\xff\x3c\x9c\xd1\x4b\x10\x92\x72\xe6\xbd\x46\xde\xd5\x16\x0b\x8a\xd7\x09\xc8\x43\xbf\x5d\x39\x85\x0d\xce\x13\xe5\x4f\xae\xbd\xa0\xde\x17\xf2\xec\xce\x7b\xdd\xf0\x31\x4f\x72\xa6\x0c\x13\x99\xaf\x0f\x3b\x8c\x8a\x62\xfa\x11\xa7\xb0\xdb\x9d\x68\x0a\x7f\x12\x20\xd7\xe2\xf9\x66\x23\x41\xf9\x93\xce\x4b\x10\xf2\x18\x4e\x41\xf3\x4c\x38\xcf\xbc\xea\xf1\xae\x11\x82\x40\x06\x8c\xc8\x73\x2d\xfb\xd5\x41\x51\xd0\x0d\x9a\xce\xe5\x49\x2e\x15\x4f\x2f\x3f\x9e\x5d\xec\x76\x35\x65\xf7\x60\x4c\x46\xf3\x53\x7d\x98\x83\xa2\x80\x44\x82\x9b\x6a\xcb\x40\xb5\x64\x8c\x34\x54\xd7\xcd\xeb\x13\x1e\x62\xe5\x40\xae\x7e\x6e\x01\x56\x9f\xe4\x2a\xe4\x2c\xc4\xca\x09\xd0\xe5\x42\xff\x5d\x0a\xd8\xd0\x5b\x8d\x93\xcf\x68\x78\xe4\x07\x48\x83\x3d\x67\x04\x6e\x9d\xbb\x1a\xe4\xba\xaf\xcb\x04\xcf\x40\x28\x0a\xd2\x68\xe9\x1e\x6c\xb4\x6c\xa0\xbe\x70\x71\xb3\x82\x30\x17\x54\xdd\xbd\x13\x3c\xcf\x2c\xe5\x22\xe4\x51\xd2\x3f\x66\x8b\x63\x4d\xb4\x0b\x7a\x58\xe9\x7d\xd9\x09\x67\x1b\x1a\xe5\xc2\x60\x22\x8d\xaa\x5a\xbe\x45\x21\x30\x8b\x00\xbd\x90\xf0\x3b\x7a\xfd\x6f\xa4\x15\x8d\x5e\xa1\xe9\x7c\x79\x4c\x88\x00\x29\x8d\xd1\x74\x18\x22\x4b\xac\x0e\xb0\x34\x0b\xcd\x8b\x8a\x42\xf3\xda\xed\x3a\x66\xe4\x42\x04\x59\xff\x0c\x3e\xf0\x7b\x29\xc6\x2b\xeb\x75\xd5\x66\x9a\x62\xa1\x2d\x5e\x89\x1c\x82\xc1\x6e\xfb\xd0\xed\xa6\x2d\x56\x30\x5f\x1e\x27\xb5\x49\x2c\x40\xc5\xdc\x20\x79\x7a\xc7\x70\x4a\x43\xaf\xcf\xcb\x93\xf9\x9a\x81\x72\xc8\xe8\x54\x42\x51\xbc\xa8\x8d\x87\x81\x5a\x99\xbd\x3d\xad\x54\xba\x39\x18\xfb\x65\x61\xab\x71\x48\x54\x89\xc3\x8b\x81\x16\x82\xe1\x49\xfb\x4f\xae\x4b\x5b\x63\x5c\xa1\xb9\xd4\x86\x36\x67\x0a\x22\x81\x15\x74\xa9\x3a\x2e\x0e\x4c\x1f\x65\xbe\x7c\xcb\xc5\x17\x2c\x08\x65\x51\x85\x72\xcf\x96\x5a\xb7\x57\x77\x99\xd1\xf8\x82\x86\x82\x4b\xbe\x51\xd3\x8f\xa5\x01\xcf\x2a\x43\xd6\xaf\x14\x1b\x1c\x82\x2c\x51\x30\x76\x59\x3a\xc0\x02\x33\x1c\x01\x39\xa5\xf2\x46\xee\x76\xe8\xa0\x1b\x0b\xbf\xce\x9f\x5d\x2e\x79\xbc\xc5\x34\xc1\x6b\x9a\x50\x75\xb7\x02\x3b\x72\xee\x13\x71\x57\x8a\x0b\x1c\x41\x57\x58\x7f\xcc\xbb\x1b\x24\x7b\x7e\x91\x25\x58\x6d\xb8\x48\xdf\xea\xd8\x7d\xca\x53\x4c\xd9\x49\x1d\xa2\xff\x35\x70\x8e\x8a\xf8\x73\x46\xb0\x02\x07\xf5\x4f\x3f\x35\xb4\x69\x29\x95\x87\x5e\x23\x4f\xeb\xc9\xf2\x7f\x84\xc6\xb5\x74\xc2\xd3\x2c\x57\x30\xc3\x36\x3a\x5d\x25\xe9\x78\x8c\x4a\x4d\x55\x18\x1c\x87\x61\x27\x02\xfc\xa9\xbc\x55\x71\xd8\x3b\x6f\xb9\x34\x69\x4b\x21\xab\x14\xf6\xd5\x39\xaa\xd9\x54\xa7\x01\x7f\x68\xc4\x59\xbe\x4e\x68\xd8\xb8\x1e\xc8\x99\x6f\xa5\xcc\x14\x4b\x05\x62\x69\x53\x69\x69\x4d\xf2\x7c\xb4\x2c\x25\x2d\x24\xca\x24\x05\xd2\x9f\x5c\xa5\x9c\x1c\x62\x42\x0e\xdb\x2c\x35\x09\x1e\x86\xb2\xc9\x5a\xc1\x83\xef\xa8\x40\x9f\x5c\x3f\x4c\xea\x4f\xae\x08\xdd\xfe\x05\xe2\xb4\xae\x5f\x12\x37\xfa\x18\xcb\xc8\x8d\x25\xe0\x72\xc3\x45\xe5\x2e\x56\x0d\x92\xae\xe8\x1f\x20\x17\x38\xf3\x27\x4e\xeb\xbc\x5c\x68\x02\x7f\x72\x3d\xb5\x45\xd5\xcc\xae\x87\xb6\x38\x74\xc9\x0a\x84\x99\xbd\xbd\xf5\x48\x54\xe7\x84\xe9\x7b\x2c\xab\xa0\xf9\xec\x1d\x91\x60\x85\x09\x95\x37\xbf\xfe\x70\xc8\x61\xd9\xa8\xc1\x59\x39\x76\xae\x00\x48\xcf\xfc\xff\x7a\xcf\x7d\x56\x72\x37\x6c\x4f\xb1\xc2\x7f\x47\x37\x6f\xab\xad\x67\x58\x12\xb9\x9a\x50\x1b\xeb\xfb\x00\xd8\xa7\xf4\xd0\xa7\xd7\xd5\x4b\xd1\x89\x7a\xfd\x82\xf1\xbb\x15\x71\xfd\xd6\xf3\xab\x20\xb0\x0b\xe4\xa7\xee\xc9\xb7\xa9\x0e\xb0\x1f\x39\x81\x7d\x3b\x73\x67\x49\x37\x16\x7d\x47\x6c\x76\xe6\xef\x15\x4b\xea\x18\xa8\x63\xca\x9e\xed\x73\x60\x85\xf4\xdb\xcb\x85\x5c\x82\xb0\x45\x7e\x26\x01\xf2\xff\xf1\x50\xf7\x14\x48\x07\x23\x35\xc6\xe3\x5a\xc6\xb3\x02\xf2\x4f\x64\xb7\xef\x69\x48\xff\x00\x0c\x1e\xcc\xda\x75\x10\x1d\x4c\xae\xee\xa9\x08\x07\x73\x86\x5e\x45\xf8\x08\x13\x3d\xb7\x40\x63\x79\x6d\x4c\x9e\x41\x16\x76\x15\xa8\x0a\x47\xed\x5c\xa1\x9b\x4d\x04\x98\xa4\xbf\xe2\xb9\x08\xc1\xf4\xff\x8d\x48\x38\x94\xc0\x22\xca\xe0\x68\x4f\x24\xbe\x0a\x01\x01\xd2\xbc\x5b\x13\xad\xf2\xcd\x86\xde\x96\x52\x74\xa7\x92\xcd\x52\xaf\x3b\xe0\x22\x8c\x41\x2a\x81\x15\x17\x83\x5d\xdd\x45\xcd\xbc\xca\xb8\x17\x38\xea\x8c\xd2\x76\x4f\x37\xf9\xfd\xf6\x89\x6f\x5b\x78\xda\x2a\x1f\x99\xeb\xd6\xc8\xce\xc9\x3e\xe6\xe5\xbb\x23\xfb\xb8\x71\x75\xc0\x43\xc8\x8b\xb1\x20\x5f\xb0\x80\xa5\xe0\x1b\x9a\x40\x5f\xa4\xb2\x1e\x1e\xad\x24\x9b\x6a\xd8\xcd\xbc\xf2\xcd\x11\xde\xc3\x09\xa1\x3d\x8f\xee\x8f\xd1\x1e\x42\x68\x34\x22\xf8\xc1\x23\x0e\xfa\xfb\xe3\xdd\xee\x40\xf6\xda\x89\x0a\x97\x23\x80\x60\x92\x52\xf6\x59\x82\x70\x55\xef\x79\xf5\xdc\x76\x25\x53\x58\x1a\xc3\x10\x4f\x63\xdb\x46\x2d\xc5\x3b\x50\x1f\xf2\x35\x08\x06\x0a\xe4\x71\x04\x4c\x95\xb7\x19\x3a\xb0\xa3\xa9\x35\x94\xf6\x12\xca\xf2\x5b\xeb\xe2\xc1\x31\x50\xf7\x08\x95\xfa\xf5\x4b\x2c\xe5\x17\x2e\xc8\x71\xae\x62\x60\x8a\xb6\xbe\x6d\xc6\x9b\xc3\x09\xbd\x8c\xdd\xe3\xf9\x72\x50\xf0\x01\xee\x86\x56\xe5\xb6\xad\x6a\xdf\x0d\xdc\xe9\x43\x18\x20\x33\x2c\x70\x0a\x0a\x84\x4e\x73\x32\xfe\xb4\x3a\x5e\xd6\x5c\xfb\x5a\xe8\xbe\x19\xab\xb8\xaf\x3c\x29\xe3\x0f\x70\xb7\xc4\x2a\x76\x5c\x07\xa0\xc1\x95\x00\xb2\x6c\xc7\x45\x61\xff\x32\x25\xdb\x7b\x2c\x7f\xd5\x50\xaf\x20\x14\xa0\xe4\xe0\x0e\x64\x88\x5d\x49\x38\x08\x9c\x9a\x49\x65\xa1\x15\xaf\x81\xd0\xfd\x04\xdd\x35\xef\xaa\x20\x70\xdb\xb8\x31\x1d\x0d\xb0\x29\x2e\xfb\xa6\x42\x53\x1c\xc1\x27\xd8\x80\x00\x16\xf6\xb7\x6a\xcf\xd9\x6c\x40\xf4\xe5\xe5\x72\xae\xb7\x9d\xeb\xb5\xa1\x5a\x4a\x43\x90\xf1\xe8\xbe\x65\xbd\xee\xd8\x2b\x6f\xf2\x91\x5d\xab\x0f\x9f\x1d\xf4\x5b\x77\xdb\x58\xed\xa9\x12\x59\x0f\xcc\x9d\xe5\xcc\xdc\x54\xdd\xc3\x93\x9b\x7c\x0f\xe7\x59\xed\x0d\x6f\x05\x4f\x0d\x53\x5b\x2f\x81\x17\xe2\x30\x2e\xef\x6d\xbc\x4f\x80\xc9\x7f\x05\x55\x16\xcd\x83\xfd\x5f\xc9\xe6\x11\x83\x49\xe0\x1f\x71\x49\xa8\xbc\x19\x58\x55\xe0\x6d\x63\xe2\x0a\x0e\xb9\xa0\x5d\x61\x44\x6d\x21\x87\x3f\x5a\xd5\x7f\x66\xab\x1a\xdc\x3f\xdb\x9e\x4c\xab\x4b\xe2\x33\x46\x32\x4e\x99\x92\xd3\x75\xc2\xd7\x81\x5f\x1a\xde\xbe\x1d\xc2\xbe\x60\xa1\xda\xa2\xa7\xdb\x98\x0c\xac\xfa\xbe\x1b\xda\xca\x1f\x19\xa0\xe9\xf9\x4a\x7b\xbe\x2e\xa7\xde\xfd\x07\xfd\x3c\x70\x48\xd2\x2c\x6a\x07\x29\x2c\xf2\x7b\x2f\x81\xbb\x02\xec\x73\x7f\x5b\xd7\x99\x5b\x2a\x54\x8e\x93\x85\x89\x27\xd0\x9b\x4f\x7e\xeb\xb4\xf0\xd9\x8e\xc7\x9a\xad\xf7\x34\x78\x3d\x64\xbe\xb3\x35\x7d\xf3\x35\xc5\xde\x2a\x9d\xc1\xad\x02\x26\xcd\x97\x20\x4f\xd1\x21\x05\xfe\x2c\x94\xf0\x5d\xbe\xa1\x69\xd4\x64\x65\xf7\xf6\xc4\xc7\x7f\xe4\x02\xa6\x67\xc3\xf3\x75\xf0\x29\x2b\xd6\x55\x28\x68\xa6\xfa\xeb\xef\x31\x23\x09\x88\x8e\x6d\xff\x32\xfd\xb9\x4b\x84\x73\xc5\x3f\x67\x91\xc0\x04\x16\x94\xf1\x0e\xa5\xfd\x51\x8a\x27\x41\x29\xca\x22\x7b\x2a\x5e\x1e\x48\x41\xa8\x80\xac\x3a\x04\xbd\xb2\x3e\xc5\x8c\x5c\xf0\xb3\x5b\x08\x73\x65\x29\xc5\x9f\xe5\x52\xcc\xd6\x94\xcd\x18\x8f\xf3\x0c\x99\xff\xae\xb1\x8c\xd1\x51\x88\x7e\xf3\xda\x9f\x33\x9e\xa9\x19\xd6\x60\xcc\x42\xce\x14\xa6\x0c\x84\x9c\x65\x82\x6f\xa9\x16\x77\x2a\x63\x64\x25\x46\x05\x0c\x33\xf3\xd1\x4a\xe0\xdb\x2b\x32\x5f\x4b\x03\x15\xe5\x6c\x4e\x86\xeb\x75\x4b\x66\x3e\x58\x1a\x2e\xb7\x96\xea\x60\xcc\xa0\x8a\xeb\xfd\x35\x26\x23\xf7\x42\x65\xc9\x55\xc7\xe7\xa6\x11\x3c\x57\x70\xa1\x7f\xb8\xd7\xab\x14\x51\x75\xca\x55\xa3\xec\x26\x95\x20\xb6\x34\x84\xa5\xa0\x2c\xa4\x19\x4e\x4e\x12\x0a\x4c\xb9\x60\x70\x53\x96\x65\xf4\x90\x3a\x34\xab\xcb\xf2\xdb\x24\xd3\x55\xf4\x29\x14\x16\x11\xa8\x33\xb6\xa5\x82\xb3\x14\x98\x83\x49\xdd\x64\xf3\x84\x86\x25\x87\x37\x6f\xd0\x6c\x8b\xc5\x2c\xe1\x51\xad\xfc\x24\x97\x0a\xc4\x51\xab\xf9\x84\x47\xe8\x97\x37\x2f\x5f\xa1\x97\xbf\x79\xe8\xa5\xdd\xc1\x1f\x74\xff\xee\x0e\xfe\x17\x00\x00\xff\xff\x90\xc3\x2b\x5e\xa5\x28\x00\x00")
+var _kubernetesagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x6f\xdb\x38\x12\x7f\x5e\xff\x15\x84\x50\x54\x31\xa0\xd8\xdb\xbd\xb7\x00\x57\x20\x97\xa4\xad\xd1\x75\x63\xd4\x69\xee\xc1\x9b\x07\x5a\x1c\xcb\x44\x24\x52\x4b\x52\x4e\xb2\x82\xfe\xf7\x03\xf5\x49\xea\x23\xb1\xb3\xcd\x5e\x7a\xb7\x49\x1e\x62\x71\x38\x9c\xf9\xcd\x37\x65\x84\x10\x4a\x47\x28\xff\x71\x70\x4c\xaf\x41\x48\xca\x99\x73\x82\x9c\xd5\x0e\x0b\x8a\xd7\x21\xc8\x23\xb7\x59\x39\x87\x0d\x4e\x42\xe5\x8e\x6f\x1c\xaf\xda\xe7\xf3\xf8\xc1\x39\xa9\xf9\xe4\x4f\x12\xa6\x72\x26\x32\x59\x1f\x19\x8c\xd2\x74\xf2\x05\x47\x90\x65\x67\x3c\x61\xca\x1d\x7b\xa8\x6f\xf1\x72\xb3\x91\xa0\xdc\xb1\x71\x08\x42\x0e\xc3\x11\x68\x9e\x21\xe7\xb1\x53\x3e\xce\x6a\x21\x08\xc4\xc0\x88\xbc\xd4\xb2\xaf\x46\x69\x4a\x37\x68\x32\x93\x67\x89\x54\x3c\xba\xfe\x72\x71\x95\x65\x15\xa5\xa9\x18\x93\xc1\xec\x5c\x2b\x33\x4a\x53\x08\x25\xf4\x53\xed\x18\xa8\x86\x8c\x91\x9a\xea\xa6\x3e\x3e\xe4\x3e\x56\x3d\xc8\x55\xcf\x2d\xc0\x2a\x4d\x56\x3e\x67\x3e\x56\xbd\x00\x5d\xcf\x35\x16\x0b\x01\x1b\x7a\xaf\x71\x72\x19\xf5\x8f\x5d\x0f\x69\xb0\x67\x8c\xc0\xfd\xd1\xa3\xc8\x99\xc7\xc5\x82\xc7\x20\x14\x05\x99\x5b\xe9\x11\x6c\xb4\x6c\xa0\xee\xb8\xb8\x5d\x82\x9f\x08\xaa\x1e\x3e\x0a\x9e\xc4\x96\x71\x11\x72\x28\x71\x4e\x86\x70\xac\x88\x32\xaf\x85\x95\xde\x17\x9f\x71\xb6\xa1\x41\x22\x72\xac\xb4\x38\xab\x7a\x15\xa1\x34\x15\x98\x05\x80\xde\x48\xf8\x1d\x9d\xfc\x13\x69\x43\xa3\x77\x68\x32\x5b\x9c\x12\x22\x40\xca\xdc\x69\x0c\x86\x8d\xef\xb6\x80\xa5\xb1\x9f\x1f\x94\xa6\x9a\x57\x96\x39\x9e\x4d\xd7\x42\xa4\x7a\x5e\x89\x41\x37\x08\x7e\x2f\xc4\x78\x67\x1d\x57\x6e\xa6\x11\x16\xda\xe3\x95\x48\xc0\xe6\x8c\x50\x5b\xe9\x66\xd3\x0e\x2b\x98\x2d\x4e\xc3\xca\x25\xe6\xa0\xb6\x3c\x47\xf2\xfc\x81\xe1\x88\xfa\x2d\x29\x11\x72\x64\xb2\x66\xa0\x7a\x64\xec\x35\x42\x9a\xbe\xa9\x9c\x87\x81\x5a\x26\xeb\xc6\x6d\xab\x5d\xa5\x6d\xac\xcf\xd9\xa8\xff\xff\x1c\x87\x50\x15\x38\xbc\xe9\x58\xc1\xeb\x6a\xda\x7e\x72\x53\xc4\x21\xe3\x0a\xcd\xa4\x76\xb4\x19\x53\x10\x08\xac\xc0\xa4\x6a\xb4\x76\x80\x69\x55\x66\x8b\x0f\x5c\xdc\x61\x41\x28\x0b\x4a\x94\x5b\xbe\xd4\x84\xbd\x7a\x88\x73\x8b\xcf\xa9\x2f\xb8\xe4\x1b\x35\xf9\x52\x38\xf0\xb4\x74\x64\x7d\xa4\xd8\x60\x1f\x64\x81\x42\xee\x97\x45\x00\xcc\x31\xc3\x01\x90\x73\x2a\x6f\x65\x96\xa1\x91\x99\x0b\x2b\x23\xb5\x31\x7e\x3c\x9e\xfb\x42\xf2\x74\x87\x69\x88\xd7\x34\xa4\xea\x61\x09\x76\xe6\xdc\x27\xe3\x2e\x15\x17\x38\x00\x53\x58\x77\x28\xba\x47\x03\x71\x11\x87\x58\x6d\xb8\x88\x3e\xe8\xdc\x7d\xce\x23\x4c\xd9\x59\x95\xa2\xff\xe1\x78\xfd\xc4\xdf\x62\x82\x15\xf4\x50\xff\xf4\x53\x4d\x1b\x15\x52\x39\xe8\x04\x39\x3a\x1a\xac\xf8\x47\x68\xd8\x4a\x67\x3c\x8a\x13\x05\x53\x6c\xa3\x63\x1a\x49\xe7\x63\x54\x58\xaa\xc4\xe0\xd4\xf7\x8d\x0c\x90\x3e\x03\xc5\xbd\xeb\x56\x9f\x25\x6d\x29\x64\x59\xc2\x1a\x86\x07\xd6\xa8\x7a\x53\x55\x06\xdc\xae\x13\xc7\xc9\x3a\xa4\x7e\x1d\x7a\x20\xa7\xae\x55\x32\x23\x2c\x15\x88\x85\x4d\xa5\xa5\xcd\x8b\xe7\x8b\x55\x29\x69\x21\x51\x14\x29\x90\xee\x78\x15\x71\x72\x84\x09\x39\x6a\xaa\xd4\xd8\x7b\x1a\xca\xba\x6a\x79\x4f\x9e\x51\x82\x3e\xbe\x79\x9a\xd4\x1d\xaf\x08\xdd\xfd\x17\xc4\xa9\xd9\x96\xc4\xb5\x3d\x7a\x63\xd6\xf4\x3f\x5c\x6c\xb8\x2a\xc3\xc5\x34\xd1\x2e\x5a\xd2\x3f\x40\xce\x71\xec\x8e\x57\x7d\x87\x5d\xcf\x35\x81\x3b\xbe\x99\xd8\xa2\x6a\x66\x37\x5d\x5f\xec\x86\x64\x09\xc2\xd4\xde\xde\x44\x64\x5d\x13\x26\x9f\xb0\x2c\x93\xe6\xab\x0f\x44\x82\x15\x26\x54\xde\xfe\xfa\x77\x40\x96\x01\x69\xec\xd2\xe0\xd8\x58\x16\x3b\x97\x00\xa4\xe5\xfe\x2f\x14\x2a\x07\x44\xee\xab\x92\xbb\x66\x7b\x8e\x15\xfe\x5f\x0c\xf3\xa6\xdb\x4a\xff\x9c\xaf\xbe\x44\x4b\xd4\x37\x84\xda\x58\x67\xde\x9f\x6b\x3d\xb4\xf6\xba\x7b\x49\x8d\xac\xd7\x6e\x18\x0f\x91\xf8\xd1\x26\xae\x3d\x7a\x3e\x0b\x02\xd3\x64\x7f\xfd\x4c\xbe\x8b\x74\x82\xfd\xc2\x49\xdd\x01\x0e\x25\xd9\x0a\xcb\xa5\xe5\x7e\x59\xf6\x68\xf6\x1d\xf0\xd9\xa9\xeb\x1d\x92\x03\x75\x37\xd0\x9b\x4f\xba\x4a\x9a\x7c\x23\x7c\x7f\x3d\x97\x0b\x10\xb6\xc8\x2d\xaa\x9a\x87\x4d\xd5\xcb\xf1\x80\x44\xf3\x64\x82\xfc\x11\x95\xaa\xd9\x76\x33\xe7\x68\xa0\xc7\x78\x59\xcf\x78\x55\x40\x1e\x50\xdd\x0e\xc0\xfc\x49\x47\xfa\x3f\xc0\xe0\xc9\xaa\x5d\x25\x51\x3b\x99\x3e\xde\x11\x76\xee\x19\x5a\x1d\xe1\x0b\xdc\xe8\xf5\x0b\x34\x54\xd7\x86\xe4\xe9\x54\xe1\xbe\x06\x55\xe1\xa0\xb9\x57\x30\xab\x89\x80\xbc\xe8\x2f\x79\x22\x7c\xc8\xe7\xff\x5a\x24\xec\x4b\x60\x01\x65\x70\xbc\x27\x12\xcf\x42\x40\x80\xcc\xcf\xd6\x44\xcb\x64\xb3\xa1\xf7\x85\x14\x06\x0b\x56\x2f\x35\x75\x52\xff\x3a\x5c\xf8\x5b\x90\x4a\x60\xc5\x45\x67\x97\xb9\xa8\x99\x97\x15\xf7\x0a\x07\x2d\x2e\x82\x87\x85\xe6\x38\x00\xa6\xcc\x95\x98\xf3\x50\x6f\xcd\x57\x6b\x45\x4c\x0a\x3f\x4c\xf4\xe4\x3e\x3b\xdf\x5f\x68\xc6\x09\xe4\x28\x15\x5b\xa4\x12\x94\x05\xfb\x86\xeb\xf8\xa6\x5b\x98\x9f\xd7\xd0\x95\x56\x7e\x19\xbb\xb6\xda\xb8\xf2\xa9\x6e\x99\x6d\x67\x1d\xb8\x91\xae\x7c\x62\x46\xf6\x09\x0c\xd7\xeb\x13\xeb\x91\xb0\x30\xc0\x43\xc8\xd9\x62\x41\xee\xb0\x80\x85\xe0\x1b\x1a\x42\x5b\xa4\xa2\x93\x6f\x63\xdb\xed\xe3\xfb\x99\x97\x59\x65\x80\x77\x27\xe7\x58\xf3\xab\x1d\xaa\xfb\x20\x34\x98\xcb\x5c\xef\x00\x73\x1f\x9a\xd0\x4c\xdd\xdb\x97\xcf\x37\xbd\xa8\x70\x39\x00\x08\x26\x11\x65\xdf\x24\x88\xda\x4d\x8d\xa3\x93\xf2\xb9\x1d\x4f\x3a\x89\x15\x19\x53\xfc\x35\xbe\xad\xff\xd2\xf4\x23\xa8\xcf\xc9\x1a\x04\x03\x05\xf2\x54\x27\x8e\xe2\x3d\x8c\x1e\x24\xd1\xc4\xa8\x39\x7a\xe2\xa2\x2c\xb9\xb7\x5e\x99\xb4\xf4\xd6\x7f\x0e\xa1\x52\x2b\xba\xc0\x52\xde\x71\x41\x4e\x13\xb5\x05\xa6\x68\x13\xdb\xf9\xc5\xac\x29\x85\xfe\x75\xa4\xdc\xf6\x70\xd3\x21\x98\x5f\x5e\x7c\x86\x87\xf6\xfb\x99\xea\xa7\xbb\x47\xff\x3a\xb7\xf0\xa0\x95\xd0\x27\xae\x62\x2c\x70\x04\x0a\x84\xee\x3c\xe4\xf6\xeb\xf2\x74\x51\x71\x6d\x5b\xa1\xf9\x71\x62\xac\xb6\x6d\xe3\x49\xb9\xfd\x0c\x0f\x0b\xac\xb6\x3d\x2f\x32\xda\x5e\xd3\xf6\x9d\x3e\x0a\xfb\x53\x3e\x8e\x7c\xc2\xf2\x57\x0d\xf5\x12\x7c\x01\xca\x6c\x39\xdb\x6f\x28\x4a\x41\x65\x41\xd8\x96\x35\xd4\x4c\x4a\x0f\x2d\x79\x75\x84\x6e\xb7\x16\xa6\x7b\x97\xad\x4c\xbf\x8f\xe7\xae\xa3\x01\xce\xdb\xe2\xb6\xab\xd0\x08\x07\xf0\x15\x36\x20\x80\xf9\xed\xad\x3a\x72\x36\x1b\x10\x6d\x79\xb9\x9c\xe9\x6d\x97\x7a\xad\x6b\x96\xc2\x11\xe4\x76\x70\xdf\xa2\x5a\xef\xd9\x2b\x6f\x93\x81\x5d\xcb\xcf\xdf\x7a\xe8\x77\xfd\x03\x6f\xb9\xa7\x2c\xc1\x2d\x30\x0d\xe8\xb4\x86\xf9\x9d\x64\x57\xf3\xbc\x53\x81\xcb\xb8\x8a\x86\x0f\x82\x47\x39\x53\xdb\x2e\x9e\xe3\x63\x7f\x5b\xbc\x71\x72\xbe\x02\x26\xff\x16\x54\x19\xef\x33\x10\x7a\x72\x72\xd5\x7f\xde\x4b\x16\x4a\xcf\x3d\xe6\x52\xdf\x66\x76\xbc\xca\x73\x76\x5b\xd2\xd1\x1d\x21\x27\x11\xd4\x14\x46\x54\x1e\x72\x54\x3e\x30\x8a\xc0\xf7\x19\xa5\x5e\xcd\x08\x71\xc0\x5c\xf0\xe4\x6c\xf4\x23\x2a\x55\xb3\xb5\x07\x1d\xaf\xf7\x3a\xa9\x3c\xda\x1d\x8f\x27\xe5\xeb\xed\x0b\x46\x62\x4e\x99\x92\x93\x75\xc8\xd7\x9e\x5b\x38\xde\xbe\xb3\xcd\xbe\x60\xa1\xca\xa3\x27\xbb\x2d\xe9\x78\x75\x36\x1a\xce\x9b\x65\x3c\x32\x40\x93\xcb\xa5\x8e\x7c\xdd\x6d\x7d\xfc\x17\xfa\xb9\x13\x90\xa4\x5e\xd4\x01\x92\x5a\xe4\xd9\xe3\x47\x64\xa3\xf6\x7f\xfb\x5c\x2c\xee\xa8\x50\x09\x0e\xe7\x79\x3e\x31\xde\x3b\x9b\xb5\xf3\x79\x97\x7c\xaf\xf9\x62\xaf\xde\xba\xea\xa6\x96\x01\x64\xbe\xb3\x37\xf5\xcd\xaf\x07\xcd\x38\x7b\x9b\x74\x0a\xf7\x0a\x98\x0e\x1c\xd9\xec\x7e\xd1\xc4\x3f\xf5\x25\xb8\xdf\x75\xa2\xb2\xaa\x7b\xa3\xf1\xe9\x1f\x89\x80\xc9\x45\x57\x3f\x03\x9f\xa2\x63\x5d\xfa\x82\xc6\xd6\xd8\xab\xf1\xfb\x84\x19\x09\x41\x18\xbe\xfd\xcb\xe4\x67\x93\x08\x27\x8a\x7f\x8b\x03\x81\x09\xcc\x29\xe3\x06\xa5\xfd\x75\x1a\x47\x82\x52\x94\x05\xf6\x7d\xbe\x6e\x4b\x04\x57\xe0\x2b\x20\x4b\x83\xa0\x5e\xce\x03\x22\x8a\x30\x23\x57\xfc\xe2\x1e\xfc\x44\x59\x46\x71\xa7\x89\x14\xd3\x35\x65\x53\xc6\xb7\x49\x8c\xf2\x7f\xd7\x58\x6e\xd1\xb1\x8f\x7e\x73\x9a\x8f\x53\x1e\xab\x29\xd6\x60\x4c\x7d\xce\x14\xa6\x0c\x84\x9c\xc6\x82\xef\xa8\x16\x77\x22\xb7\xc8\x2a\x8c\x0a\x18\x66\xf9\xd7\x6d\x3c\xd7\x5e\x91\xc9\x5a\xe6\x50\x51\xce\x66\xa4\xbb\x5e\x8d\x64\xf9\x57\xad\xba\xcb\x8d\xa7\xb6\x57\x8a\x6f\x07\x69\x57\xe9\xae\x31\x19\xf4\x2f\x94\x9e\x5c\x4e\x7c\xfd\x34\x82\x27\x0a\xae\xb4\x62\xfd\xeb\x65\x89\x28\x27\xe5\x72\x50\xee\x27\x95\x20\x76\xd4\x87\x85\xa0\xcc\xa7\x31\x0e\xcf\x42\x0a\x4c\xcd\xc8\xbe\x94\x45\x1b\xdd\xa5\xf6\x73\x3e\x8b\xe2\x5b\x55\xf9\x54\xd1\xa6\x50\x58\x04\xa0\x2e\xd8\x8e\x0a\xce\x22\x60\xaa\x4b\x52\x8e\xbb\x0b\x1e\x52\xbf\xe0\xf0\xfe\x3d\x9a\xee\xb0\x98\x86\x3c\xa8\x8c\x5f\x5c\xd4\x1c\x37\x96\x0f\x79\x80\x7e\x79\xff\xf6\x1d\x7a\xfb\x9b\x83\xde\x5a\x45\xab\xae\x12\x23\x84\x10\xca\x46\xff\x19\x00\x19\x0d\xe5\x2f\x5f\x29\x00\x00")
 
 func kubernetesagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -966,7 +966,7 @@ func kubernetesmastercustomscriptSh() (*asset, error) {
 	return a, nil
 }
 
-var _kubernetesmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x1b\x5d\x6f\xdb\xb6\xf6\x7d\xbf\x82\x10\x2e\xae\x9a\xc1\xb1\x1b\x37\x03\x76\x03\xdc\x01\x69\x92\x2e\x46\x9b\xc4\xa8\xb3\xee\xa1\x0b\x06\x9a\x3a\xb6\x89\xc8\xa4\x46\x52\x4e\x32\xc3\xff\xfd\x82\xfa\xb0\x24\x92\x72\xe8\xc4\xe9\xc7\x9d\x1f\xda\x48\x3c\x3c\x3c\x3c\xdf\xe7\x90\x42\x08\xa1\xe5\x0f\x28\xfb\x05\x38\xa1\x9f\x40\x48\xca\x59\x70\x84\x82\xcf\x0b\x2c\x28\x1e\xc7\x20\x5f\x85\xd5\xc8\x29\x4c\x70\x1a\xab\x70\xef\x26\xe8\x94\xf3\x62\x4e\xb0\x72\xcc\x2a\xdf\x37\x80\x19\x9e\x83\x09\x38\xc7\x52\x81\x38\x5e\x60\x1a\xe3\x31\x8d\xa9\x7a\x18\x41\x73\x89\x44\xf0\x04\x84\xa2\x20\x83\x23\xb4\x5c\xad\xdf\xab\x87\x24\xc3\x76\x41\x89\xe0\x92\x4f\x54\xf7\x84\xcf\x93\x54\x41\x0f\x37\xb1\xc9\x20\x9b\x52\xcc\xdc\x6a\xcb\x23\xc5\x05\x9e\x42\x83\x9e\x08\x12\x60\x91\xbc\xd2\xd3\x3e\x17\x2f\x11\x0a\x3e\x13\xce\x08\x56\xaf\xc2\x8a\x9e\x4b\x50\x77\x5c\xdc\xf6\x92\x74\x1c\x53\x32\x18\x1e\x47\x91\x00\x29\x41\xf6\xc2\x0e\xb2\x78\x30\x6c\x42\x5d\xe2\x39\x84\x7b\x7b\x37\x41\xb1\xc4\xcd\xae\x79\x5e\xec\xed\x98\x10\x9e\x32\x95\x2f\xd7\xca\xf6\x6a\x9f\x38\x87\xbf\x2e\xb8\x5f\xc7\xbb\x98\x8f\xe8\xdf\x20\x2f\x70\x12\xee\xd9\xeb\x7d\xba\xd0\xa3\xe1\xde\x4d\x57\x36\x56\xd6\x98\xd6\xbb\xdc\x24\xde\x82\xe0\x5e\x73\x7a\x25\xdd\xe5\x92\x4e\x10\xe3\x0a\x75\x2f\x72\x86\x0a\x3e\xa1\x31\x74\x07\xf2\x24\x95\x8a\xcf\x3f\x5d\x9e\x5d\xaf\x56\xdb\x2b\x81\x4b\xef\xb7\x57\x02\x96\xff\x3f\x02\x92\x0a\xaa\x1e\x7e\x15\x3c\x4d\x4c\x45\x60\x72\x5a\x89\x7d\xbd\x9d\x81\xd4\x94\x0f\x98\x82\xa9\xc0\x0a\xa2\x62\x0f\xfa\xd7\xf1\x5a\x5a\xf0\x54\xc1\x75\xb6\x86\xb1\x60\x35\x52\x5f\x17\x58\xb5\xc6\x0e\xd5\x6e\x41\x85\x4a\x71\x5c\x50\xe5\xaf\x70\xb9\x3d\x8c\x12\x4c\xa0\x31\x52\x8d\x0d\x05\x4c\xe8\x7d\x36\xf1\x73\x6d\x18\x19\xeb\x33\x50\x27\x34\x12\x61\x65\x54\xd9\x0e\xd7\x7f\xaf\x6a\xec\x94\xe9\x98\x69\xd7\xd1\xc4\xb8\x6c\x62\x77\xed\x32\x9f\x68\xee\x6e\xf3\x1e\x5d\xbb\x71\xe3\xb5\x71\x6a\x32\x1c\xaa\xe5\xc0\x8f\x50\x40\x23\x13\x2d\x93\xd3\xc1\xa9\xc1\x91\x8c\x17\x5e\xfa\x97\xff\x2c\x82\x2a\xb5\xf2\x25\xa3\x9a\xd1\x4a\x4d\x5d\x2b\xcb\xb7\xae\xbf\x6f\x3c\x5c\x49\x69\x19\x4d\x95\xac\xbb\x92\x6a\xb5\xaf\x1f\x23\xd7\x6e\xc1\xc3\x5a\x64\xa1\x04\x1f\xd3\xb8\xb0\x87\x4c\x8e\xdd\x73\x2c\x7f\xa7\x2c\xe2\x77\xb2\xc1\xc4\x16\x85\xc6\x71\xcc\xef\xfe\x14\x51\x12\x74\xd0\x56\x1a\x4c\x08\x48\x3d\x12\x1c\x6b\x0c\xe6\xec\xcc\x71\x4a\x22\x68\x52\xf2\x23\x03\x43\x1f\x4f\x87\x48\x09\x3c\x99\x50\x82\x14\x47\x79\xbc\x70\x4f\x56\x94\x65\x4c\x3b\x36\x6d\xe5\xc7\xcd\xf0\x43\x2e\xd4\x47\xcc\xa6\xd9\xf6\xde\xbc\xf9\xf9\x3f\xfb\xfa\x1f\xd7\x1c\x2a\x80\x94\xe4\x0d\xd8\x98\xa7\x2c\x72\x80\x25\x82\x72\xcd\xe7\xe0\x08\x1d\xbc\xee\xbb\xc6\xb9\xe2\x84\xc7\x1a\xcb\x35\xb1\xf8\xa8\x25\xc5\x53\x41\xc0\x6b\x1f\x39\x68\x63\x0b\x3f\x06\xed\xa6\xb0\xd6\xdf\xe2\x85\xaf\xbc\xa5\x9c\x6d\xe9\xb0\x4c\x71\x7b\x49\x7b\x34\x3a\x77\x49\x7b\x4b\x61\xfb\xca\xba\xdf\xdf\xef\xf7\x1d\xe0\x4e\x31\x6f\x94\xf2\x81\x63\xd8\x10\xb2\xbf\x8c\x9f\x2d\xe2\xea\x61\xa3\x4c\x6f\xd3\x31\xfc\xa9\x62\xf9\x25\x04\xab\xd7\xda\xc7\x09\x95\x20\x16\x20\xd0\x2b\x15\xcb\xbd\x2f\x28\xe9\xc3\xc3\x37\xfb\x87\x87\x6f\x76\x22\xeb\xd7\xdf\x90\xac\x9f\x14\xd9\x9c\xe9\xa6\x9d\x2a\xb7\xc4\xf6\xaf\x1f\xf3\xcc\xcc\xb4\x06\xde\xbe\xe9\x5a\xa2\xfb\x32\xa1\xfc\xff\xa5\xf6\xfb\x30\xf6\x4e\x28\xc6\x98\xdc\x02\x8b\x4a\xbd\xe6\x3c\x7e\x42\x52\x5c\xae\xfa\x36\x47\xa6\xb1\x94\x04\xb8\x35\xbd\x96\x89\x4f\x04\x67\x0a\x58\x34\x18\x9e\x70\x36\xa1\xd3\x54\x64\x3b\x7d\x06\x15\x25\xa6\x27\x25\xe9\x86\x40\x37\x26\xb8\x02\x72\x53\x1f\x44\x5e\xaa\x11\x76\xb6\x55\x0c\x9b\x73\xe6\x93\x9b\xa7\x31\xc7\xd1\x5b\x1c\x63\x46\x28\x9b\x56\xa9\xe2\x63\xcc\xfc\xf0\x56\xc3\x9e\x5f\x5f\x0f\x47\xdb\x31\xad\x45\x86\xbe\xd5\x81\x29\x38\x77\x8d\x60\xf9\x59\x5b\x75\x37\x2e\x58\x18\xb1\x6b\xdd\xd3\x70\xaf\x83\xc2\x9e\xc3\x16\x9c\xe6\xec\x50\x74\x1f\x7a\xeb\x21\x46\xb9\x42\x4c\xc9\x46\x1d\x3a\x82\x23\x74\x78\xf8\xa6\x6d\xcf\x1b\x20\x80\x69\x5a\xdf\xc5\x1c\x2b\xca\xa6\x83\x61\x70\x84\x26\x38\x96\x60\x01\xd2\x28\x86\x6b\x3a\x07\x9e\xaa\x01\xbb\xa0\x2c\x55\x99\x70\x7f\xb2\x00\xb5\x36\x9d\x52\xa9\x04\x1d\xa7\xa5\x73\x2a\xbc\xa7\x2b\xcc\xf2\xf1\xe6\xb2\xf0\x11\x39\x84\xbd\x0c\x85\xec\x29\x92\x64\xaa\x38\xd4\x8f\xce\xa2\xb1\xed\xc9\x6d\x14\x39\x5a\x3f\xb7\xd2\x58\x7b\x4b\x07\xf2\x98\x94\x93\x76\xd9\x51\xa6\x40\x2c\x70\x3c\x60\x23\x20\x9c\x45\x59\x8a\xf6\x93\xa3\x23\x90\xce\xc7\x20\xae\x26\xc3\x72\x4b\x41\x7f\xd7\x09\x46\xe5\x42\x40\x18\x89\xc5\xd4\x6a\xc1\x9d\xf0\x94\x29\x74\xf0\x32\x61\x38\x2f\x70\x1f\xed\xf9\x21\xb3\x1f\x54\xb5\x3e\x96\x4b\x88\x25\xb4\xc1\x2d\x18\xa8\x0a\xf0\x65\x7a\x63\xb9\x86\xeb\x3c\x4c\x30\x1c\xff\x93\xc3\x73\xc5\x83\xe7\x85\x69\x41\x17\x58\x41\x3d\x4e\x37\x16\xd3\xb5\x8a\x60\xa0\x40\x1e\x0f\x07\xa3\xac\x60\x19\x0c\x9d\xdd\xb5\x0a\x53\x5c\x8a\xf3\x02\xd4\x8c\x67\xce\x6a\xa4\xb0\xa2\xc4\x91\xe2\x67\xdd\x3a\xdf\xf8\xa6\x35\x6c\x94\xcd\x68\xe9\x7e\xb5\x3d\x3d\x2d\xba\xb7\x09\x63\xcd\xfa\xa7\x86\xf9\x1d\x05\xdc\x9a\x0a\x7c\x99\xc0\x6b\x06\xcd\xe7\x44\xcd\x9d\xa4\x3a\xb6\x11\x78\xa6\x3c\x1e\x19\x82\x77\x58\x37\x03\xd5\xb7\x19\x4e\x9f\x13\x12\xdb\x43\xaf\x83\x6f\x4f\x61\xc7\x0e\xe2\xe9\xb7\xd4\x88\xde\x50\x93\x78\x84\xaa\x88\xc9\x11\x28\x6d\x3e\xa6\x20\x83\x88\xcf\x31\x65\x1a\xd3\x07\x3c\x86\xd8\xbd\xee\xbb\xbf\x22\x96\xf7\x55\x1a\xa6\x50\x3f\xb5\x59\x97\x54\x0e\x57\x7d\xfa\xc0\xf0\x9c\x12\x9f\x93\xc6\xd6\x0a\xed\xe9\x27\xc9\x2e\x79\x10\x9e\x3c\x34\x59\x94\x9d\x68\x66\x48\x64\x3a\xb6\x1d\x63\x96\x46\x69\x8f\x68\x8d\x5c\x4d\x26\x12\x54\xe6\xed\x6a\xec\x28\x65\x58\x3a\xc7\x0f\x9c\x27\x97\x3c\x02\x9b\x07\x6d\x8d\x8d\x96\x24\x7c\x67\x9d\x89\xf6\x5c\x3f\x57\x2b\xed\xfc\xc3\x0e\x0a\x47\xa3\xf3\x7d\x97\xc3\xff\x74\xa1\xe1\x4a\xad\xe8\x20\xcd\xd2\x01\x8b\xe0\xde\x46\xb9\x66\xd1\x16\x69\x55\xe1\x46\xfb\xfd\x1a\x53\x1f\x8f\x04\x9e\x31\x60\xfb\x42\x77\xe5\x58\xa3\x20\xb1\x79\x56\x28\x67\x97\x58\xe9\x11\x19\xee\x7d\xf6\xe1\xc9\x4d\x43\x6f\x5a\x5c\xdd\xd6\x6e\xac\x47\xf3\x66\xeb\x25\x56\x79\x1a\xf2\x7d\x9a\x0f\xa3\xc4\xd7\x72\x9e\x5d\x8b\x74\xbc\x8b\x91\x8e\x75\x26\xea\x51\x3a\x1b\x02\xe9\xe5\x76\xf5\x98\x59\x79\x5a\x95\x5f\xf5\xa7\x7f\x1d\xaf\xe2\x67\xc7\xe5\x56\x1b\x73\x4c\x1f\x12\x32\x4a\xb4\xb3\xd9\x95\x2f\xa1\xc9\x53\x2a\x23\x9a\x90\x6c\xd6\xc1\x76\xe9\x50\xdd\xfe\xde\x3e\x5a\x1b\xba\x28\x28\xa9\xfe\x2a\x4d\xb1\xf5\xc5\x83\x0d\x5a\x64\xce\xb0\x50\x98\xa9\x9b\x7b\x87\x5f\xbb\x0c\x69\xbb\xd5\xd0\xc8\xa2\x0b\x4a\xeb\x52\x1d\x18\x3e\x75\xc7\x12\x7d\x69\x1f\x61\x33\xe1\xb1\xcd\x3f\x56\xca\x17\x59\x69\x01\x95\x68\xa8\x27\x85\xbd\x6a\xb9\x39\x16\x3a\xb2\x28\x91\xda\xf5\xdd\xb7\xdd\x0e\xc8\x6c\x67\xe3\x85\x9d\xe5\x52\x60\x36\x05\xf4\x2f\x09\x7f\xa1\xa3\xff\xa2\x98\xf3\x04\xf5\xad\x98\x55\x32\x3b\xb3\xba\x06\x02\x8f\xa3\xe7\xd2\x77\x2d\x97\x7a\x95\xd5\x6a\xeb\xd6\x4d\x21\x00\x77\x85\xbd\x51\x02\x65\x96\xff\xf5\x3b\x32\x9a\xd5\xb9\x75\x9b\x56\x7e\xb3\xed\xdd\xbe\x3c\xe5\x1c\x0c\xdf\x71\x71\x87\x45\x44\xd9\xb4\xd0\xce\x1f\xaa\x25\xbc\xf3\x8e\x8e\xcf\x7d\x31\xaf\x9b\x62\xe6\xce\xb6\x38\x97\xce\x7c\xeb\x04\x93\xef\x36\x27\x5c\xcc\xb7\x2f\xa6\x1e\xbd\x1c\x5a\x71\xc5\x19\x56\x76\x94\xa8\xb8\x49\x69\xbb\x3c\xdd\x73\xb8\x7e\xeb\xba\xb6\x81\xdd\xf3\xae\xb1\x95\xd7\x29\x3c\x95\xc1\x51\xf1\x54\x97\xa1\x80\xcc\xcc\x47\xd9\x69\x6a\x80\x6a\x61\x2c\xc4\x44\x02\x9b\x52\x06\x2f\x51\x1f\x22\x14\x94\x67\xb8\x1a\xcf\x28\x9d\x4c\xe8\x7d\xbe\x7e\xdd\x32\xd6\x43\xcd\x06\x72\xc0\x05\x99\x81\x54\x02\x2b\x2e\xac\x59\xf5\x41\x8d\xbc\xd0\xea\x6b\x3c\xad\xf1\x66\xf5\x85\x72\xde\x5d\xe5\xba\x86\xf6\xb4\x78\x15\xf7\xb9\x78\x9b\x06\x7a\x2a\xa0\xb3\x4a\x9e\x61\x11\xdd\x61\x01\x85\x4f\x34\xe9\xc9\x6f\xac\xbb\x13\x8a\xf5\x7d\x75\x37\xe6\xc2\x64\x5b\x10\xdb\x6e\xce\x4c\xd3\xec\x46\xa6\xcf\x9d\x01\x0b\x6f\xd8\xf1\x14\xf1\x56\xce\xc2\x8c\x78\xf5\xa8\xe6\xbe\x2f\xcd\x65\x0b\x27\x70\x34\xa7\xec\x37\xa9\x33\x69\xbb\xa1\x98\x16\xef\xcd\x83\x97\x80\xe4\xba\x20\x5e\x5a\x91\x33\x49\x2c\x7f\x05\xf5\x7e\x7d\x1e\x94\x07\xd1\x3c\x74\x9e\x62\x85\x51\xb7\x91\xfe\x04\x31\x65\xe9\xfd\xe6\xde\x7e\x10\x51\xa9\x97\x1e\x62\x29\xef\xb8\x88\x8e\x53\x35\x03\xa6\x68\x65\xc1\x3a\x78\x9b\x59\x91\x94\xb3\xf6\x0b\x27\xef\xe1\x61\x8b\x54\xff\x16\x1e\x34\xe9\x8e\xbe\xd0\xb0\xc4\xa6\xc7\x5d\xe7\x5d\xf9\x9a\x58\xcd\x1c\x93\xdf\xc3\xc3\x10\xab\x99\x23\x21\x72\xe4\xf1\x9e\x29\x92\xce\x5b\x3e\x68\x96\x96\x69\xcb\x39\x96\x23\x20\x02\x94\x6c\x4d\x3b\x03\x99\x03\x58\xbe\xb0\x86\xa7\xc0\x61\xd0\x6a\xa6\x62\x8d\x2b\xff\x79\x90\x6a\xd1\xe3\x08\x2b\x7c\x4a\xe5\xad\x87\x25\x67\xe1\x0a\xae\xd6\xb7\x23\xcf\xe6\x89\x7a\x70\x5d\x4b\x94\xb7\xda\xc5\xfc\xfa\x56\x03\x1d\xf4\x7f\xb6\x41\xe2\x54\x23\xb0\x2f\x23\xbe\x88\x59\x74\xc2\x7d\x50\x24\xd2\x64\x39\x0f\x42\x17\xb3\xc8\x9d\x3e\xa7\x82\xd6\x89\x11\x30\x01\x01\x8c\xc0\x2b\x3b\xcb\x68\xf9\x86\xc7\x95\x64\xb8\x72\x86\xbd\xce\xe6\xcf\xb4\xf6\xba\x45\xf1\x70\xc6\xa2\x84\x53\xa6\x64\x77\x1c\xf3\x71\x27\x5c\xcc\x22\xaf\x9c\x6a\x4b\x3e\x75\x17\xb3\x68\xab\xf2\xa0\x51\xdd\x06\x74\x8e\xa7\xf0\xb1\x64\x97\xed\x46\xf8\x64\x02\xc2\x54\x72\x2e\x07\x7a\xda\x95\x1e\x73\x1d\x8b\x6b\x03\x97\xb3\xd6\x79\xc3\x72\xdc\x31\x57\xde\xa6\x2d\xb3\x46\xb7\xa9\x03\x7e\xe1\x4e\xd1\x8b\x39\x85\x70\xcc\x5b\x04\x0d\x0e\x70\xa9\x6d\xca\xde\x39\xc1\x64\x96\x97\x39\xc1\x47\xc0\xd1\xef\x82\x2a\xcb\x5f\x9a\x66\xf6\x4e\xf0\x79\xb6\x70\x50\x5e\x70\x05\xb3\x24\xba\x1a\x9d\xae\x8d\x0e\xbd\x36\x1a\x2f\x4d\x83\x5c\x2e\x37\xcc\x5d\xad\xec\xee\x2b\x7a\x49\xc3\xe4\xd2\x6d\x96\x2d\x46\xf9\x1d\x99\xe4\x8e\x2c\x32\x67\x90\xd3\x1e\xdd\xb1\xc7\xa7\x68\x2d\x33\xd2\xe2\x33\xa1\x8b\x4c\x29\xff\xe1\x25\x6b\x0b\x4f\xbc\x0a\xd6\xed\x7a\x84\x4f\xec\xf7\x7b\x8b\xb1\x07\xf7\x0a\x98\xcc\x5a\xf2\x2f\x5b\x39\x75\xc2\x1e\x91\xb0\xc3\x23\x85\x86\x93\xaf\x36\x7a\xfc\x77\x2a\xa0\x7b\x66\x6f\xab\xc6\x96\x3c\xb1\x1d\x65\x5f\x6f\x98\xe3\xe7\x98\x45\x31\x88\x9a\x1a\xf7\xbb\xaf\xeb\x40\x38\x55\xfc\xb7\x64\x2a\x70\x04\x17\x94\xf1\x1a\x64\xb3\x57\x1a\xc8\xda\x41\xff\xca\x38\x59\x04\xa2\x20\x6a\xbb\x09\x40\xf8\x7c\x8e\x59\x74\xcd\xcf\xee\x81\xa4\xaa\x21\x8b\xb0\x97\x4a\xd1\x1b\x53\xd6\x63\x7c\x96\x26\x28\xfb\x73\x8c\xe5\x0c\xed\x13\xf4\x47\x50\x3d\xf6\x78\xa2\x7a\x58\x33\xa3\x47\x38\x53\x98\x32\x10\xb2\x97\x08\xbe\xa0\x9a\xdc\xae\x9c\xa1\x86\xe3\x51\xc0\x30\x53\x79\xfb\xbb\x39\x22\xd3\xf1\xfa\x43\x97\x41\x64\x8f\x97\xd5\x5a\xd6\x43\xb3\x87\x2b\x05\x75\x20\x5e\x7f\x26\x6a\x8e\xad\xbf\xf7\x33\x07\x5c\x5f\xd0\x5a\x14\x19\x1f\x4e\x98\xe3\x85\x37\x2e\x2a\xe8\xa2\x80\x76\x83\x4a\x10\x0b\x4a\x60\x28\x28\x23\x34\xc1\xf1\x49\x4c\x81\x29\x17\x1b\xdc\x90\x79\x0a\x6e\x43\x93\x6c\xb4\x68\xd8\xbf\x87\x07\x1b\x42\x61\x31\x05\x75\xc6\x16\x54\x70\x36\x07\xe6\x40\x52\xd6\xdf\x3c\xa6\xc4\x81\x01\x27\x34\xbf\xd5\xb7\x69\x19\x82\x4f\xb4\x75\x4d\x74\x61\xe6\xd8\xbf\x7d\xed\xc4\x84\xb8\x4d\xc7\x90\x97\x82\x1b\x11\x55\x60\x9b\xa8\xa9\x8a\xe1\x4e\x88\x7e\xf9\x05\xf5\x16\x58\xf4\x62\x3e\x2d\x35\x39\x4e\x35\x39\xfb\x95\x1a\xc7\x7c\x8a\xfa\xbf\xfc\xfb\xe0\x8f\xc0\x68\xcb\xd6\xff\x5f\xfd\xf0\xbf\x00\x00\x00\xff\xff\x38\xef\x60\x57\xe9\x41\x00\x00")
+var _kubernetesmasterresourcesT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x1b\x6b\x6f\xdb\xb6\xf6\xbb\x7f\x05\x21\x5c\x5c\x35\x83\x63\xb7\x6e\x06\xec\x06\xb8\x03\xd2\x24\x5d\x8d\xe6\x61\xd4\x59\xf7\xa1\x0b\x06\x9a\x3a\xb6\x89\xc8\xa4\x46\x52\x4e\x33\xc3\xff\xfd\x82\x7a\x93\xa2\x64\x39\x8f\x6e\xbb\x4b\x8d\xc2\x16\x0f\xcf\xe1\x79\x9f\x43\x52\x08\x21\xb4\xe9\xa1\xe4\xcf\xc3\x11\xfd\x0c\x42\x52\xce\xbc\x63\xe4\x7d\x59\x63\x41\xf1\x2c\x04\xf9\xca\x2f\x47\xce\x60\x8e\xe3\x50\xf9\x07\xb7\x5e\x3f\x9f\x17\x72\x82\x95\x63\x56\xfe\xdc\x00\x66\x78\x05\x36\xe0\x0a\x4b\x05\xe2\x64\x8d\x69\x88\x67\x34\xa4\xea\x61\x0a\x26\x89\x48\xf0\x08\x84\xa2\x20\xbd\x63\xb4\xd9\x16\xcf\xd5\x43\x94\x60\xbb\xa4\x44\x70\xc9\xe7\x6a\x70\xca\x57\x51\xac\x60\x88\x4d\x6c\xd2\x4b\xa6\x64\x33\xf7\x62\x79\xaa\xb8\xc0\x0b\x30\xd6\x13\x40\x04\x2c\x90\xd7\x7a\xda\x97\xec\x21\x42\xde\x17\xc2\x19\xc1\xea\x95\x5f\xae\xe7\x0a\xd4\x3d\x17\x77\xc3\x28\x9e\x85\x94\x8c\x27\x27\x41\x20\x40\x4a\x90\x43\xbf\x8f\x6a\x32\x98\x98\x50\x57\x78\x05\xfe\xc1\xc1\xad\x97\x91\xb8\x7d\x6e\x99\x67\xbc\x9d\x10\xc2\x63\xa6\x52\x72\x8d\x62\xcf\x9e\x6a\xb1\xa5\xf0\x37\x0f\x51\x0d\xef\x7a\x35\xa5\x7f\x80\xbc\xc4\x91\x7f\x50\xa7\xf7\xf9\x52\x8f\xfa\x07\xb7\x03\x69\x50\xd6\x98\x0a\x2e\xdb\xd4\x9b\x2d\x78\x68\x4e\x2f\xb5\xbb\xd9\xd0\x39\x62\x5c\xa1\xc1\x65\x2a\x50\xc1\xe7\x34\x84\xc1\x58\x9e\xc6\x52\xf1\xd5\xe7\xab\xf3\x9b\xed\xb6\xf7\x2c\x76\xbf\xbf\x11\xb0\xd4\x18\xa6\x40\x62\x41\xd5\xc3\x4f\x82\xc7\x91\x6d\x08\x4c\x2e\x4a\xb5\x17\xec\x8c\xa5\x5e\xf9\x98\x29\x58\x08\xac\x20\xc8\x78\xd0\x9f\x7e\x27\xd2\x82\xc7\x0a\x6e\x12\x25\x59\x04\xcb\x91\x2a\x5d\x60\x25\x8d\x67\x34\xbb\x35\x15\x2a\xc6\x61\xb6\xaa\xee\x06\x97\xfa\xc3\x34\xc2\x04\x8c\x91\x72\x6c\x22\x60\x4e\xbf\x82\x34\x94\xa1\x3f\x26\x7d\x06\xea\x94\x06\xc2\x2f\x9d\x4a\x7f\x6e\x8b\xef\x85\xf1\x21\xe4\xc9\x78\xc6\x40\xd9\x18\xab\xc4\x1b\xb8\x4c\x27\xda\xdc\xb5\xf3\xe8\xe2\xc6\x8d\xb7\x8e\x53\x2f\xc3\x61\x5a\x0e\xfc\x08\x79\x34\xb0\xd1\x32\xb9\x18\x9f\x59\x12\xd1\x9f\x6d\x27\xfb\xb3\xad\x30\x23\x53\x9a\x55\xd7\x65\x94\x33\x1a\x57\x53\xb5\xca\xfc\xa9\xeb\xfb\x6d\xcf\xd2\xa6\x23\x94\xe4\x9e\x61\x9a\x64\x35\x94\x94\xd4\x9e\x1c\x2b\x9e\xec\x38\x45\x58\xe8\xe0\x2d\x32\x33\x82\x4f\x71\x98\xf9\x43\xa2\xc7\xc1\x07\x2c\x7f\xa1\x2c\xe0\xf7\xd2\x10\x62\x83\x41\xe3\x30\xe4\xf7\xbf\x89\x20\xf2\xfa\x68\x2f\x0b\x26\x04\xa4\x26\xeb\x9d\x68\x0c\xf6\xec\x24\x7b\x4a\x22\x68\x94\xcb\x23\x01\x43\x9f\xce\x26\x48\x09\x3c\x9f\x53\x82\x14\x47\x69\xbe\x70\x4f\x56\x94\x25\x49\xee\xc4\xf6\x95\xef\xda\xe1\x27\x5c\xa8\x4f\x98\x2d\x12\xf6\xde\xbe\xfd\xe1\x3f\x87\xfa\x3f\xd7\x1c\x2a\x80\xe4\xcb\x1b\xb3\x19\x8f\x59\xe0\x00\x8b\x04\xe5\xda\xd9\xbc\x63\xf4\xe6\xf5\xc8\x35\xce\x15\x27\x3c\xd4\x58\x6e\x48\x4d\x8e\x5a\x53\x3c\x16\x04\x3a\xf1\x91\x82\x1a\x2c\x7c\x67\xba\x48\x55\xa7\xa5\xfd\x66\x0f\xba\xea\x5b\xca\xa5\xd7\x37\x01\xf6\x54\x77\x27\x6d\x4f\xa7\x1f\x5c\xda\x6e\x51\x9e\x4b\x48\x5d\x75\x3d\x1a\x1d\x8e\x46\x5e\xbf\x9b\x9a\x5b\xb5\xfc\xa6\xbf\x53\xc9\xdd\x75\xfc\x64\x15\x77\xd4\xe9\x5d\x3c\x83\xdf\x54\x28\xbf\x85\x62\x35\xad\x43\x1c\x51\x09\x62\x0d\x02\xbd\x52\xa1\x3c\xf8\x86\x9a\x3e\x3a\x7a\x7b\x78\x74\xf4\xf6\x59\x74\xfd\xfa\x2f\xa4\xeb\x47\x65\x36\x67\xb9\x59\x2f\x95\x1b\x72\xfb\x9f\x9f\xf3\xca\x82\xa0\x96\xfa\x9a\x99\x2e\x27\xbd\x50\x2a\xff\x7f\xe9\xfd\x2e\x66\x9d\x0b\x8a\x19\x26\x77\xc0\x82\x6c\x65\x13\xce\xc3\x47\x14\xc5\x39\xd5\x77\x29\x32\x8d\x25\x5f\x40\xcf\x65\xf5\x05\xc3\x08\x79\x73\xc1\x99\x02\x16\x8c\x27\xa7\x9c\xcd\xe9\x22\x16\x09\xa7\x4f\x58\x45\x8e\xc9\x96\x41\xbb\x24\xf2\x51\x53\x55\xad\x05\xae\x80\xd4\xd5\xc7\x41\x27\xd3\xf0\xfb\xfb\x1a\x46\x5d\x72\xf6\x2f\xb7\x4c\x43\x8e\x83\x77\x38\xc4\x8c\x50\xb6\x28\x4b\xc5\x7c\xbc\x49\x98\x17\xef\x34\xec\x87\x9b\x9b\xc9\x74\x3f\xa1\x35\xe8\xb0\x55\x78\x2d\x8a\x73\xf7\x08\xe6\x8a\x9c\xa6\xdb\x4a\x30\x73\x62\x17\xdd\x33\xff\xa0\x8f\xfc\xa1\xc3\x17\x9c\xee\xec\x30\xf4\x2e\xeb\xad\xa6\x18\xe5\x4a\x31\xb9\x18\x75\xea\xf0\x8e\xd1\xd1\xd1\xdb\x26\x9e\x5b\x20\x80\xe9\xb5\xbe\x0f\x39\x56\x94\x2d\xc6\x13\xef\x18\xcd\x71\x28\xa1\x06\x48\x83\x10\x6e\xe8\x0a\x78\xac\xc6\xec\x92\xb2\x58\x25\xca\xfd\xbe\x06\xa8\xad\xe9\x8c\x4a\x25\xe8\x2c\xce\x83\x53\x16\x3d\xeb\x3c\x44\x82\xcf\xe0\x29\x7a\xf0\x87\x09\x0a\x39\x54\x24\x4a\x4c\x71\xa2\x7f\xba\x0c\xa2\xd7\xf4\xcb\xed\x14\x29\xda\x6e\x61\xc5\xa0\xbd\x9f\x2f\xec\xd4\x72\xd4\xac\x3b\xca\x14\x88\x35\x0e\xc7\x6c\x0a\x84\xb3\x40\xeb\xc3\xfb\xbe\x8e\x82\xc5\xab\x19\x88\xeb\xf9\x24\x67\xc9\x1b\x79\x5d\xa4\xd1\xb3\x4c\xb3\xa5\xc0\x28\x43\x08\x88\x6a\xb6\xa5\x73\xb4\xa8\x6d\xc1\x9d\xea\x9d\x3a\xf4\xe6\x65\xd2\x70\x42\x73\xf7\x9e\x5f\x6d\x3f\xa8\xdc\xfa\xd8\x6c\x20\x94\xd0\x04\xb7\x66\xa0\x4a\xc0\xa2\x98\x78\x81\xb4\xac\xeb\x30\xc1\x70\xf8\x4f\x4e\xcf\xa5\x0c\x72\x8c\xb6\x2c\xda\x25\x52\x8c\xd2\x35\x56\x50\x64\x4e\x9b\x98\xee\x55\x04\x03\x05\xf2\x64\x32\x9e\x26\x0d\xcb\x78\x52\xa7\x62\x60\x0a\x73\x75\x5e\x82\x5a\xf2\x24\x58\x4d\x15\x56\x94\xd4\x27\xa5\xbb\x75\xad\x61\xae\xb2\x18\x6d\x61\xd3\x78\x56\xda\x59\x0e\x6b\x0b\xde\xfe\xe5\x56\xc9\xae\xec\xde\xa4\x8c\x42\xf4\x8f\x4d\xf3\x75\x63\x7c\x54\xa0\xaf\x98\xc0\xb7\x49\xbc\x76\xd2\x7c\x4a\xd6\x6c\xf0\x87\x56\x41\x74\x70\x02\xb7\x61\x34\x52\x9f\xb4\xe4\x90\xae\x69\xdd\x4e\x54\x7b\x5a\xe1\x37\x4a\xa7\x4f\x49\x89\xcd\xa9\xf7\xe8\xed\xb3\x88\xa3\x67\xe9\xe9\x11\xf9\xf4\x19\xbb\xd7\x3c\x7c\xd9\xb3\xf2\xe7\x06\x70\xae\x9a\x2f\xdd\x7a\x92\xca\xcc\x06\x7d\x79\x01\x93\x53\x50\xba\xe8\xb4\x15\xe9\x05\x7c\x85\x29\xd3\x0e\x7b\x81\x67\x10\xba\xe9\xbe\xff\x3d\x60\xe9\xc6\x90\xe1\x0a\x15\x27\x28\x9b\x33\x47\xa8\x3e\x7b\x60\x78\x45\x89\xd7\xb3\xa6\xb5\xe8\xa4\xd6\xa1\x15\x7a\x79\x16\x7d\x10\x1e\x3d\x98\x22\x4a\x0e\x44\x13\xee\x65\x3c\xab\x07\xc6\xa4\x8c\xd2\x11\xb1\x36\x72\x3d\x9f\x4b\x7d\x3a\x54\x41\x5f\xd1\x61\x1e\x1c\x2f\x38\x8f\xae\x78\x00\x75\x19\x34\x6d\x6c\xd4\x08\x5d\xcc\x8c\x48\xf4\xd4\x12\xa8\xb9\xd6\xd7\xc6\xa0\x59\xf5\x75\xa0\xf7\xa7\xd3\x0f\x87\xae\x80\xff\xf9\x52\xc3\xe5\x56\xd1\x47\x5a\xa4\x63\x16\xc0\xd7\x57\xcd\x22\xea\x62\xab\x66\x46\x18\x8d\xfa\xbd\x3d\x32\x41\xc7\x1c\xd0\x18\xfd\x1b\xa3\xfe\xd6\x41\x23\x5b\xa2\x81\x46\xca\xe5\x15\x56\x7a\x44\xfa\x07\x5f\xba\xc8\xe4\xb6\x94\x49\x73\xa8\xeb\xe2\x32\x46\x18\x1b\xd2\x74\xb3\xf5\x0a\x2b\x5d\x51\xfc\x5d\xdd\x87\x51\xd2\xd5\x73\x9e\xdc\x8b\xf4\x3b\x37\x23\x66\x72\x30\xf6\x21\x5d\x16\xa5\x2b\x29\xdf\x56\xc8\x30\xf5\xab\x5d\x6e\xd5\xd1\xab\xba\x75\x7f\xfa\x5f\xbf\xb5\xe6\xc9\x33\x8a\xc5\xe0\x4b\xc5\x1a\x3b\x86\xf8\x8c\x12\x1d\x6c\x3a\x72\xbd\x33\x96\xd0\xc8\x88\x02\x1d\x4b\x22\x1a\x91\x64\xd6\x9b\x8a\x45\xb6\x91\xc9\x46\xab\xfe\x97\xd5\xc2\x2d\xbd\xa1\x6b\x05\x66\x70\xfa\xc6\x9b\x62\xc5\xc5\x83\x16\x2b\xca\x21\xf3\xbf\x1a\x8a\x7e\x27\x0e\x77\xb2\xf8\xc2\x6d\x48\xd3\xad\x86\x8a\xa1\x3b\x3a\x3a\xdd\x20\x9b\x31\xf5\x99\x35\xfa\xd2\x31\x22\x5f\x4e\xfe\xb7\x9b\xf9\x5d\xad\x7c\x56\x95\x66\x50\x91\x36\xf7\x47\xa5\xbd\x92\xdc\x0a\x0b\x9d\x59\x94\x88\xa1\x65\x35\x7f\xc5\xed\x80\xc4\x77\x1a\x0e\xf5\x32\xd3\xd8\x08\x7d\x34\x8e\xfe\x25\xe1\x77\x74\xfc\x5f\x14\x72\x1e\xa1\x91\xed\x6c\x85\xb0\x13\xaf\x33\x10\xf4\x7b\x4d\x76\x56\x8b\x5d\x9b\x8d\xa6\xb2\xdd\xee\x17\xc2\x4a\x05\xb8\x3b\xec\x56\x0d\xe4\x55\xfe\x9f\xa7\x82\xfc\x9b\x16\x75\xea\xdd\xb6\x97\xdf\x76\xba\x5b\x55\x2b\x39\xc7\x93\xf7\x5c\xdc\x63\x11\x50\xb6\xc8\xac\xb3\x40\xbd\x47\xdd\xd1\xef\x72\x5f\xcc\x21\x92\x72\xbb\xb4\x29\x7e\x75\xa9\x0f\x33\xda\x9a\x63\x31\xc7\xe4\x6f\x5b\x13\xae\x57\xfb\x37\x53\xcd\xa7\xc4\x35\xa9\x38\xd3\xca\x33\x15\x2a\xee\xa5\x34\x5d\x9e\x1e\xfa\xfd\xdd\xd7\xb5\x2d\xec\x1d\xef\x1a\xf7\xac\x88\xef\x29\xbc\x90\xde\x71\xf6\xab\xaa\x43\x01\x89\x9b\x4f\x93\xd3\x54\x0f\x55\xd2\x98\x8f\x89\x04\xb6\xa0\x0c\x5e\xa2\x3f\xd4\x77\x19\xb3\x33\x5c\x2d\xfa\x69\x3c\xd7\xb7\x3a\x90\xed\x19\xc5\x50\xd5\x16\x11\xf2\xb8\x20\x4b\x90\x4a\x60\xc5\x45\x6d\x56\x75\x50\x23\xcf\xac\xfa\x06\x2f\x2c\x2c\x82\x87\x90\xcc\xae\xdd\x9f\xf1\xa2\xac\xbe\x68\x18\x26\x61\xac\x67\x8c\xcf\xba\x2f\x99\xf1\x00\x12\x5b\x4a\xa7\xe8\x43\x3d\xb6\x78\xd5\x51\x6c\x35\x4f\xc8\xe3\xb3\xed\xc4\xf9\xf3\x2a\xf1\xc2\xb5\x72\xd5\x3e\xb7\x32\x9b\xd2\x8e\x67\x99\x7c\x43\x28\x74\x1f\xe6\x37\xb9\x4d\x47\xaf\x29\xe8\x14\x32\x43\xc8\x5b\x62\x11\xdc\x63\x01\x59\x20\xb7\xd7\x93\x5e\xb3\xb7\x45\x6a\x5d\xb2\x77\x63\xce\xe2\x4c\x03\xe2\x5a\x14\xaa\xd5\x96\x55\xf0\xdd\xb2\x69\x8c\x6e\x7e\xbf\xa3\x8a\xf7\x8a\x70\x55\xa6\xed\x54\x7c\xeb\x14\x07\x97\x0d\x92\xc0\xc1\x8a\xb2\x9f\x25\x88\xc2\x26\x2b\x74\xe3\xec\xb9\xe9\x39\xda\xdd\x52\x5b\x10\x2f\x6d\xc8\xfa\xb3\xd9\xfc\x04\xea\x63\x71\x88\x95\x66\xfe\x34\xdf\x9f\x61\x85\xd1\xa0\x92\xf1\xb5\x23\x52\x16\x7f\x6d\xdb\x8c\xd2\x9b\xb0\x54\x6a\xd2\x13\x2c\xe5\x3d\x17\xc1\x49\xac\x96\xc0\x14\x2d\x3d\x58\xd7\xc3\xc6\x22\x74\x59\x25\x97\xcd\xb7\x64\x3e\xc2\xc3\x1e\xfd\xc9\x1d\x3c\xe8\xa5\xdb\xe2\x96\x72\x39\xc9\xb1\xe9\x71\x5b\xec\xf9\x9f\x17\x61\xb5\x74\x4c\xfe\x08\x0f\x13\xac\x96\x86\x4f\xb8\x4c\xc4\x34\x13\x7b\xb4\xfa\x3d\x2d\xb6\x2e\xb4\x48\x33\xfb\xd1\xd7\xab\xa7\x40\x04\x28\xf3\x7a\x75\x75\x9d\x9e\x4c\x01\xec\x25\x86\x15\x3c\x19\x0e\x6b\xad\x66\x95\x65\x9a\x70\xf6\x32\x4c\x36\xdf\x52\x85\x17\x60\x85\xcf\xa8\xbc\xab\x6b\xc1\xd6\x40\x9a\x63\xe1\xba\xb8\xd2\x79\xbe\x8a\xd4\x43\x4d\xd0\xda\x48\xee\x74\x88\xf9\xe9\x9d\xe6\xe3\xcd\xe8\x87\x3a\x48\x18\x6b\x04\xf5\x1b\x94\x2f\xe2\x16\x7d\xff\x10\x14\x09\xf4\xb2\x5c\x86\xe1\xad\x97\x81\xc3\x3e\x11\xf2\x62\x41\xab\x8b\x11\x30\x07\x01\x8c\xc0\xab\xec\x81\xbf\xf3\xc5\x23\x57\x65\xe4\x2a\x74\x0e\xfa\xce\xf2\x35\x03\xf5\x0f\x0e\x06\x59\xc7\x73\xce\x82\x88\x53\xa6\xe4\x60\x16\xf2\x59\xdf\x5f\x2f\x03\xf7\xfe\x82\x25\xa8\x3d\xe5\x34\x58\x2f\x03\x87\x37\x6c\x7b\x4d\xbf\x8c\x96\xdc\xa3\x2b\xbc\x80\x4f\xb9\xb8\x6a\xc2\xf5\xf8\x7c\x0e\xc2\x36\x72\x2e\xc7\x7a\xda\xb5\x1e\xab\xeb\x29\x3d\xb7\x91\xcb\xc6\x79\x93\x7c\xdc\x31\x57\xde\xc5\x0d\xb3\xa6\x77\xb1\x03\x7e\xed\xee\x2b\xb2\x39\x99\x72\x2c\xf9\x54\x3c\x4e\x97\x75\x52\xfb\x54\x9d\x73\x82\xc9\x32\xed\xcd\xbc\x4f\x80\x83\x5f\x04\x55\xb5\x78\x69\xbb\xd9\x7b\xc1\x57\x09\x61\x2f\xbf\x95\x0b\x76\x1f\x77\x3d\x3d\x2b\x9c\x0e\xbd\x36\xe2\x8b\xed\x90\x9b\x4d\xcb\xdc\xad\x75\x9e\xf8\xe2\x8e\xc9\xa5\xdb\x2d\x1b\x9c\xf2\x6f\xe4\x92\xbb\x24\xb4\x97\x80\x9c\xfe\xb8\xed\xb9\xbe\x6f\x7b\x96\x3d\x3a\x3a\xed\xbc\x22\xcd\xde\x6d\xba\x4c\x8c\xf2\x1f\xde\x67\x37\xc8\xa4\x53\x97\xdd\x45\x97\xae\x66\x76\xaf\xde\xa7\xb3\x1a\x87\xf0\x55\x01\xd3\x6a\x29\x5f\xe9\x78\x29\x07\x1e\x12\x09\xfe\xf3\xb5\x59\x46\x90\x2f\x19\x3d\xf9\x23\x16\x30\x38\xaf\xb3\x55\x11\x4b\x5a\xd8\x4e\x93\x57\x4e\xec\xf1\x0f\x98\x05\x21\x88\x8a\x19\x8f\x06\xaf\xab\x40\x38\x56\xfc\xe7\x68\x21\x70\x00\x97\x94\xf1\x0a\xa4\xb9\xc1\xeb\xc9\xca\xed\x84\xad\x75\x1c\x0a\x44\x41\xd0\x74\x7d\x81\xf0\xd5\x0a\xb3\xe0\x86\x9f\x7f\x05\x12\x2b\x43\x17\xfe\x30\x96\x62\x38\xa3\x6c\xc8\xf8\x32\x8e\x50\xf2\x75\x86\xe5\x12\x1d\x12\xf4\xab\x57\xfe\x1c\xf2\x48\x0d\xb1\x16\xc6\x90\x70\xa6\x30\x65\xfa\x04\x35\x12\x7c\x4d\xf5\x72\x07\x72\x89\x8c\xc0\xa3\x80\x61\x96\x6c\x4f\xf6\x7d\x73\x44\xc6\xb3\xe2\xed\x9c\x71\x50\x1f\xcf\xbb\xb5\x64\xe3\xaf\x3e\x5c\x1a\xa8\x3d\x52\x7d\xb7\xd5\x1e\x2b\x5e\x52\xb4\x07\x32\x03\xce\x9a\x41\x37\x8c\xfd\xb6\x87\x3d\x9e\x45\xe3\xac\x83\xce\x1a\x68\x37\xa8\x7e\xf9\x88\x12\x98\x08\xca\x08\x8d\x70\x78\x1a\x52\x60\x6a\x1c\x74\x85\x4c\x4b\xf0\x3a\x34\x49\xf0\x4c\xd2\xbd\xe7\x8f\xf0\x50\x87\x50\x58\x2c\x40\x9d\xb3\x35\x15\x9c\xad\x80\xa9\x3a\x48\xd6\x09\x4f\x78\x48\x89\x03\x03\x8e\x68\x7a\x15\xb1\x8d\x0c\xc1\xa7\x7a\xef\x7c\xae\x1b\x33\x07\xff\xf5\xbb\x32\x36\x84\xbe\xfa\x98\xb6\x82\xad\x88\x4a\xb0\xb6\xd5\x94\xcd\x70\xdf\x47\x3f\xfe\x88\x86\x6b\x2c\x86\x21\x5f\xe4\x96\x9c\xee\x40\x1d\x96\x66\x1c\xf2\x05\x1a\xfd\xf8\xef\x37\xbf\x7a\x46\xce\x2b\x32\x5b\x0f\x21\x84\xb6\xbd\xff\x0d\x00\xf3\xda\x1e\x64\x9e\x42\x00\x00")
 
 func kubernetesmasterresourcesTBytes() ([]byte, error) {
 	return bindataRead(
@@ -1026,7 +1026,7 @@ func kubernetesparamsT() (*asset, error) {
 	return a, nil
 }
 
-var _kuberneteswinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x5f\x6f\xdb\x38\x12\x7f\x6e\x3e\x05\x21\x74\x4f\x31\xa0\x38\xd7\xbb\x97\x43\x0f\xbb\x40\x2e\x4e\x5a\xa3\xeb\xc4\x5b\xa5\x59\x1c\x92\x3c\xd0\xe2\x58\x21\x22\x91\x2a\x49\x39\xc9\x1a\xfe\xee\x07\x92\x92\x4c\x4a\x72\xe2\xb6\x9b\x6c\x6e\xb7\x7e\x09\x62\xcd\x0c\xc9\xdf\xfc\xe6\x0f\x47\x46\x08\xa1\xe5\x0e\x32\x9f\x00\x17\xf4\x1c\x84\xa4\x9c\x05\x6f\x51\x70\xb1\xc0\x82\xe2\x59\x06\x72\x37\x5c\x3f\x19\xc1\x1c\x97\x99\x0a\x07\x57\x41\x54\xeb\x25\xbc\xb8\x0f\xde\x36\x76\xcc\x37\x25\x53\xc6\x88\x2c\x67\xbb\x8e\xa1\xe5\x72\x78\x82\x73\x58\xad\x0e\xb5\x44\x38\x88\x50\xdf\xc3\xd3\xf9\x5c\x82\x0a\x07\xce\x22\x08\x05\x0c\xe7\xa0\x6d\x66\x9c\x17\x41\xf5\xf5\xaa\xd9\x04\x81\x02\x18\x91\xa7\x7a\xef\x17\x3b\xcb\x25\x9d\xa3\xe1\x58\x1e\x96\x52\xf1\xfc\xfc\xe4\xe8\x6c\xb5\xaa\x25\xdd\x83\x31\x99\x8e\x47\xfa\x30\x3b\xcb\x25\x64\x12\xfa\xa5\x16\x0c\xd4\x5a\x8c\x91\x46\xea\xaa\x59\x3e\xe3\x09\x56\x3d\xc8\xd5\xdf\x7b\x80\xd5\x27\xb9\x48\x38\x4b\xb0\xea\x05\xe8\x7c\xa2\xff\x4e\x05\xcc\xe9\x9d\xc6\x29\x64\x34\xd9\x0b\x23\xa4\xc1\x1e\x33\x02\x77\xbd\x5a\x0d\x72\xee\x72\x85\xe0\x05\x08\x45\x41\x1a\x2f\xf5\x62\xf3\xca\x6e\x0c\xd4\x2d\x17\x37\x31\x24\xa5\xa0\xea\xfe\x9d\xe0\x65\x61\x74\x5e\xd9\xe7\x94\xb4\xcf\xb7\x06\xf0\x55\xe5\x0f\x1f\x21\xad\x54\x1c\x72\x36\xa7\x69\x29\x0c\x12\xd2\x38\x08\x35\x9f\xe5\x52\x60\x96\x02\x7a\x2d\xe1\x33\x7a\xfb\x23\xd2\xee\x45\x6f\xd0\x70\x3c\x3d\x20\x44\x80\x94\x86\x2a\x8e\x41\xe4\x30\xcd\x83\x93\x16\x89\x59\x68\xb9\xd4\xb6\x56\x2b\x87\x3c\x7d\x38\x20\xef\x63\x50\x81\xcf\x76\x1b\x6f\xbc\xe5\x2a\x65\x9a\x63\xa1\x79\xae\x44\x09\x51\x47\xdb\x3f\xf4\x5a\x69\x81\x15\x8c\xa7\x07\x59\x4d\x84\x09\xa8\x6b\x6e\x60\x1c\xdd\x33\x9c\xd3\x24\x68\xdb\x0a\x64\x39\x63\xa0\x7a\xf6\xd8\xeb\x81\xe5\xf2\x75\x4d\x19\x06\x2a\x36\xba\x95\x4b\x3c\x55\x7f\x73\xee\x7f\x1e\xb4\x1a\x86\x4c\x59\x18\x5e\x77\x9c\x10\x75\x0f\xda\xfe\xe6\xca\x12\x8c\x71\x85\xc6\x52\xb3\x6b\xcc\x14\xa4\x02\x2b\x70\xa5\x9c\xb8\x06\xa6\x4f\x32\x9e\x1e\x73\x71\x8b\x05\xa1\x2c\xad\x40\x6e\x51\x69\x1d\xeb\xea\xbe\x30\x0e\x9f\xd0\x44\x70\xc9\xe7\x6a\x78\x62\x89\xbb\x5f\x11\x58\x2f\x29\xe6\x38\x01\x69\x41\x30\xb4\xb4\xac\x9f\x60\x86\x53\x20\x23\x2a\x6f\xa4\x35\xbd\xfc\xb6\x18\xee\x0b\xc3\x83\x05\xa6\x19\x9e\xd1\x8c\xaa\xfb\x18\xfc\x6c\xb9\x4d\x96\x8d\x15\x17\x38\x05\x77\xaf\xe1\xa6\x88\x6e\x80\x6c\x45\x45\x91\x61\x35\xe7\x22\x3f\xd6\xf9\x7a\xc4\x73\x4c\xd9\x61\x9d\x96\xff\xd9\x09\x8d\x4a\xf8\x53\x41\xb0\x82\x1e\x69\x9b\x00\x8c\x6c\x6e\x77\x15\xa0\xb7\x28\xd0\x6e\x5a\xf3\x6c\x15\xed\x6c\x76\xd1\x21\xcf\x8b\x52\xc1\x3e\xf6\xb1\x71\x3d\xa4\x33\x30\xb2\x6e\xaa\x10\x38\x48\x12\x27\xfa\xbf\xa8\x52\x55\x16\xb6\xae\x54\x7d\x7e\xf4\x77\x21\xab\xa2\xf5\xd5\x55\xa9\x51\xaa\x13\x7f\xd8\x65\x70\x51\xce\x32\x9a\x34\x71\x07\x72\x3f\xf4\x8a\x64\x8e\xa5\x02\x31\xf5\xa5\xf4\x6e\x4d\xb9\x7c\xb2\xba\x24\x3d\x24\x6c\x59\x02\x19\x0e\x2e\x72\x4e\x76\x31\x21\xbb\xeb\xba\x34\x88\x1e\x87\xb2\xa9\x53\xd1\xa3\x6b\x54\xa0\x0f\xae\x1e\x17\x0d\x07\x17\x84\x2e\xfe\x80\xed\xac\x03\xdf\x0a\x37\xfe\xd8\x54\x83\x1b\x26\x60\xab\x70\x56\x85\x8b\xd7\x75\xe4\x31\xfd\x0d\xe4\x04\x17\xe1\xa0\x97\x9d\xe7\x13\x2d\x10\x0e\xae\x86\xfe\x56\xb5\xb1\xab\x2e\x17\xbb\x21\x59\x81\xb0\xef\xab\xaf\x23\x12\xd5\x05\x61\xf8\x1e\x4b\x27\x63\xbe\xe8\x40\x24\x58\x61\x42\xe5\xcd\xcf\xdf\x03\xb2\xdb\x28\x6a\x70\xe2\x1e\xcd\x18\x80\xb4\xe8\xff\xc7\x47\xee\x8b\xda\x77\x63\x76\x84\x15\xfe\x33\x86\xf9\xba\xd5\x7a\x81\x0d\x51\xdf\xb5\xd3\xc7\xfa\x21\x00\xb6\x69\x3d\x3a\xa7\xdf\xdc\x30\x7e\x71\xea\xdb\xd4\xc7\xb5\xee\x9c\x5f\x8f\x85\xdf\x28\x3f\xf7\x85\x7c\x91\xeb\x5c\x7b\xc2\x09\x6c\x7b\x2d\xef\xed\xee\x36\x25\xe2\x0d\xf4\xdd\x0f\xb7\x4a\x2b\x75\x3a\xd4\xe9\x65\xcb\xbb\x73\xe4\x65\xf7\xbb\xf3\x89\x9c\x82\xf0\xb7\xfc\x42\x72\xe5\xff\xe3\xa1\x1e\xec\x95\x7a\xbb\x8d\xa7\x25\xc6\x8b\xc2\xf1\x0b\xea\xdc\xef\xc9\xa3\xbf\x00\x06\x8f\xd6\xef\x3a\x87\x76\xe6\x57\x0f\xf4\x86\x9d\x71\x43\xab\x37\x7c\x82\x69\x5e\xff\x86\x36\x55\xb8\x4d\xfb\xe9\xd4\xe3\xbe\x56\x55\xe1\x74\x3d\x5f\x70\x8b\x89\x00\x53\xfe\x63\x5e\x8a\x04\xcc\x1c\xa0\xd9\x12\x4e\x24\xb0\x94\x32\xd8\xdb\x12\x89\xaf\x42\x40\x80\x34\x6b\x6b\xa1\xb8\x9c\xcf\xe9\x9d\xdd\x85\x63\xe2\x96\xb2\x8f\x8e\x54\xbd\xa0\x67\x86\x8b\xe4\x1a\xa4\x12\x58\x71\xd1\x31\xe0\x3e\xd4\x16\xaa\xea\x7b\x86\x53\x67\xb6\xb6\x7a\xbe\x01\xf0\xb7\x0f\x7e\xd7\x5d\x86\xef\xfd\xd6\xa4\xb1\x9e\x31\xd6\x20\x8f\xc9\x36\x4c\x0b\xfb\x73\xfc\x66\x9e\x39\xe0\x21\x14\x5c\x63\x41\x6e\xb1\x80\xa9\xe0\x73\x9a\x41\x7b\x4b\xb6\x49\xde\xd8\x5e\x36\x2d\x72\xbf\xf1\x2a\x4c\x37\xd8\xee\xce\x0c\xfd\x01\x75\x7b\xb2\xf6\x18\x42\x1b\x93\x43\x18\x3d\xe1\xbc\xbf\x3d\xef\x75\x47\xb4\x57\xbd\xa8\x70\xb9\x01\x90\xc4\xba\x58\x3c\x0f\x4b\x0d\xc0\xcb\x77\xa0\x3e\x94\x33\x10\x0c\x14\xc8\x5f\x29\x23\xfc\x56\x1e\xa4\xc0\x94\x7d\x4b\xa1\x93\x36\x1a\x7a\x73\xe7\x00\x93\x9c\xb2\x4f\xd2\xd9\xa7\x1f\xfe\xc6\x84\x2b\x13\xb6\x56\xb5\x16\xa6\x58\xca\x5b\x2e\x3a\x73\x75\xd7\x42\x2d\xb3\x91\x61\x55\x21\xea\x07\xd4\x9c\x4e\x9f\xc0\x34\x35\xed\x63\xd0\x1c\xa7\xf0\x11\xe6\x20\x80\x25\x6d\x55\xed\xa6\xf9\x1c\x44\xe7\x36\xa0\xa1\xa9\x60\x3a\xd5\x02\xed\xb3\xe9\xe8\x2f\x67\x19\x95\xd7\x0f\x2b\x4f\x6b\xa1\x1e\x03\xf2\xa6\x7c\x48\x35\xbe\x29\x7b\x94\x16\x1b\xae\x2f\x8e\x62\x95\x49\x5b\xaf\x28\x56\x9e\x6b\xb8\xe9\x00\xbb\x68\x98\xda\x03\xa7\x45\x9d\x6a\x8f\x05\xcf\xc7\x1a\x41\x9f\xfd\x51\x90\xe0\xe4\xda\xbe\x4a\x08\x3e\x02\x26\xbf\x0a\xaa\x20\x78\xfc\x02\x62\x95\x9f\x90\xf9\x51\xb8\xc7\x25\xa1\xf2\xa6\xf3\x86\x26\x0a\x16\xd7\xa4\xe7\xad\x4f\x50\x0a\xea\x6e\x46\xd4\x5c\xd9\xfd\x7e\x57\xfa\x6b\xde\x95\xa2\x87\xe7\xac\x83\x61\xf5\xb2\xf2\x88\x91\x82\x53\xa6\xe4\x70\x96\xf1\x59\x14\x5a\xe2\x6d\xdb\xa3\x6e\x0b\x16\xaa\x19\x3d\x5c\x5c\x93\x0e\xab\xd7\x0d\xb5\x7d\x2d\x08\x68\x78\x1a\xeb\xd8\xd6\x15\xfb\xdd\x7f\xd0\xdf\x3b\xc1\x47\x9a\x87\x3a\x18\x96\x9e\x78\x4f\x7f\xee\x96\xba\x6d\x5e\x15\xd6\x0d\xcc\x82\x0a\x55\xe2\x6c\x62\xf2\x04\xb4\xa6\x61\xdf\x3a\x9b\x7a\xb1\x13\x98\x46\xf5\x81\x4b\x44\x0b\x99\xdf\x99\x2f\xdf\x3c\x14\xdf\xda\xa5\xfb\x70\xa7\x80\x49\xf3\x9b\x83\xe7\x68\xbd\x23\x14\xee\x27\x12\x1e\x18\x52\xae\xe1\xf7\x8a\x73\xe7\x24\xae\x8f\xeb\xe3\xda\x46\x28\x4e\x04\x2d\xd4\x51\x7d\xb0\xb6\xe0\x7b\xcc\x48\x06\xc2\xe1\xec\x9b\xe1\xbf\x5c\x21\x5c\x2a\xfe\xa9\x48\x05\x26\x30\xa1\x8c\x3b\x92\xfe\xcf\x1a\x02\x09\x4a\x51\x96\xfa\xb3\x55\x7b\x20\x05\x89\x02\x12\x3b\x02\xad\xee\x31\xc7\x8c\x9c\xf1\xa3\x3b\x48\xf4\x59\xdc\xeb\x61\xc1\x6f\x41\xc8\x6b\xc8\xb2\x21\xdc\x01\xda\xb3\x32\x94\xb3\x29\xcf\x68\x72\x8f\x3e\x31\xa1\xef\x5c\x54\x2f\x80\xf6\x2a\x53\xe8\x32\x08\x23\x14\xbe\xc6\x22\x2d\x73\x60\x4a\xa2\x1f\x91\xcf\x49\x49\x59\x9a\xc1\x2f\x25\x57\x10\xea\x0a\x3b\x31\xef\x64\xc6\x53\xe4\xd5\xbd\x9b\xa6\xc1\x3c\x98\x8e\x63\x10\x0b\x2d\xa2\xe5\xd1\x9e\xee\x3d\x47\x4c\xea\x2f\x69\x02\xe3\xa2\xab\xe8\x3e\xb5\x3a\x76\x91\xe3\x5f\x46\x27\x96\x29\xbe\x8e\x7d\x2d\x74\xfc\x99\xb0\x86\x47\x21\xda\xfb\xb9\x22\xb4\x2f\xbb\xa6\xb9\x96\x31\x6d\xef\x07\xb8\xf7\x65\x92\x8c\x82\xae\x16\xe6\x07\x25\x1f\xe0\xbe\x92\xfd\xad\x14\xf0\x9e\x4b\xa5\x69\x8d\xfa\xef\x60\x2d\x36\x6f\xdd\xa7\xa0\xbd\x83\x83\xd1\xa1\x59\x76\x4c\x7c\xdb\xd2\x22\x31\x15\x94\x25\xb4\xc0\x59\x2d\x15\xfa\x6a\x31\x24\x02\xd4\x36\xaa\x56\x32\xf4\xeb\xa4\xe7\x54\x14\xa2\x7f\xb7\xbc\x5e\x75\xe8\x6e\x60\xd8\x61\x80\x11\xbf\x0c\xd0\x4f\xe8\x87\xf8\xbf\xf1\xd9\xd1\x64\xf4\x71\x7c\x7e\xf4\xc3\xe5\xa5\x81\x4b\x77\xe2\x97\x97\xeb\x7b\x45\x0c\xaa\x2c\xac\xfa\x30\xe3\x29\xfa\xc7\x4f\x7f\x7b\xe3\x37\xfa\x3b\xee\xdf\xd5\xce\xff\x02\x00\x00\xff\xff\xe7\xde\x1c\x88\x35\x27\x00\x00")
+var _kuberneteswinagentresourcesvmasT = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x4f\xe4\x38\x12\x7f\x9e\xfe\x2b\xac\x68\xf6\x42\x4b\xa1\xb9\xb9\x7b\x39\x71\xda\x95\x38\x1a\x66\xa2\xd9\x86\xde\x09\xc3\xea\x04\x3c\xb8\x93\xea\x60\x91\xd8\x19\xdb\x69\x60\xa3\xfe\xdf\x4f\xce\xa7\x9d\x8f\xfe\x60\x97\x3d\xf6\x6e\x27\x2f\x43\x5c\x55\xae\xfa\xd5\xa7\x9d\x46\x08\xa1\x6c\x84\xf2\x7f\x16\x4e\xc8\x35\x70\x41\x18\xb5\x8e\x91\x75\xb3\xc2\x9c\xe0\x45\x04\xe2\xc0\x6e\x56\xa6\xb0\xc4\x69\x24\xed\xf1\x9d\xe5\x54\x7c\x3e\x4b\x9e\xad\xe3\x5a\x4e\xfe\x26\xa5\x32\x17\x22\xd2\xc5\x81\x26\x28\xcb\x26\x17\x38\x86\xf5\xfa\x94\xa5\x54\xda\x63\x07\xf5\x2d\x5e\x2e\x97\x02\xa4\x3d\xd6\x36\x41\xc8\xa2\x38\x06\x25\x33\x62\x2c\xb1\xca\xd7\xeb\x5a\x89\x00\x12\xa0\x81\xb8\x54\xba\xdf\x8c\xb2\x8c\x2c\xd1\xc4\x15\xa7\xa9\x90\x2c\xbe\xbe\x38\xbb\x5a\xaf\x2b\x4a\xdd\x30\x2a\x42\x77\xaa\x8c\x19\x65\x19\x44\x02\xfa\xa9\x56\x14\x64\x43\x46\x83\x9a\xea\xae\xde\x3e\x62\x3e\x96\x3d\xc8\x55\xef\x0d\xc0\x2a\x4b\x6e\x7c\x46\x7d\x2c\x7b\x01\xba\x9e\x29\x2c\xe6\x1c\x96\xe4\x49\xe1\x64\x53\xe2\x1f\xda\x0e\x52\x60\xbb\x34\x80\xa7\x83\x8d\xc8\xe9\xdb\x25\x9c\x25\xc0\x25\x01\x91\x7b\xa9\x17\x9b\x77\x8a\xd4\xa2\x20\x1f\x19\x7f\xf0\xc0\x4f\x39\x91\xcf\x1f\x39\x4b\x93\x9c\xe7\x5d\xb1\x4e\x02\xeb\x78\x08\xc0\x77\xa5\x3f\x4c\x84\x10\xb2\x48\x72\xca\xe8\x92\x84\x29\xcf\x11\x52\x4a\xdc\xd4\xab\x08\x65\x19\xc7\x34\x04\xf4\x5e\xc0\x37\x74\xfc\x3d\x52\xee\x45\x1f\xd0\xc4\x9d\x9f\x04\x01\x07\x21\xf2\x50\xd1\x04\x36\x11\xdb\x82\x93\x24\x7e\xbe\x51\x96\x29\x59\xeb\xb5\xe5\x98\x74\x2d\x1c\xaa\xf7\x95\x1a\x64\x89\xe0\x5b\xa1\xc6\x07\x63\xbb\x92\x99\xc4\x98\xab\x38\x97\x3c\x05\x53\x32\x42\x6d\xa3\x1b\xa6\x15\x96\xe0\xce\x4f\xa2\x2a\x10\x66\x20\xef\x59\x0e\xe3\xf4\x99\xe2\x98\xf8\x2d\x2d\x11\xb2\x44\xba\xa0\x20\x7b\x74\xec\xf5\x40\x96\xbd\xaf\x42\x86\x82\xf4\xd2\x45\x13\xac\x15\x57\xfe\xac\x47\x43\x7f\xe9\xff\xcf\x61\x88\x64\x01\xc3\xfb\x8e\x13\x9c\xae\xa1\xed\x37\x77\x45\xf2\x51\x26\x91\x2b\x54\x74\xb9\x54\x42\xc8\xb1\x04\x9d\xaa\x31\xda\x02\xaa\x2c\x71\xe7\xe7\x8c\x3f\x62\x1e\x10\x1a\x96\x20\xb7\x42\xa9\xc9\x75\xf9\x9c\xe4\x0e\x9f\x11\x9f\x33\xc1\x96\x72\x72\x51\x04\xee\x51\x19\xc0\x6a\x4b\xbe\xc4\x3e\x88\x02\x84\xb5\x53\x57\x84\x19\xa6\x38\x84\x60\x4a\xc4\x83\x28\x44\x57\x28\x5b\x95\x8b\xda\x08\x6f\xce\xe1\xbe\x34\x3c\x59\x61\x12\xe1\x05\x89\x88\x7c\xf6\xc0\xac\x96\xbb\x54\x59\x4f\x32\x8e\x43\xd0\x75\xb5\x87\x32\x7a\x34\x90\x15\x49\x84\xe5\x92\xf1\xf8\x5c\xd5\xeb\x29\x8b\x31\xa1\xa7\x55\x59\xfe\xbb\xe5\xf4\x13\x7f\x4d\x02\x2c\xa1\x87\xba\x28\x00\xea\xb1\xe2\x42\x2b\x0b\x1d\x23\x4b\xe5\x42\x13\x67\x6b\x67\x34\xec\xa2\x53\x16\x27\xa9\x84\x23\x6c\x62\xa3\x7b\x48\x55\x60\x54\xb8\xa9\x44\xe0\xc4\xf7\xb5\xec\xcf\x5e\x80\xe1\xce\x9d\xaa\xcf\x8f\xa6\x16\xa2\x6c\x5a\x8d\xc0\x3d\xbb\x52\xcd\x54\x15\x7e\xbb\x1b\xc1\x49\xba\x88\x88\x5f\xe7\x1d\x88\x23\xdb\x68\x92\x31\x16\x12\xf8\xdc\xa4\x52\xda\xe6\xed\xf2\xd5\xfa\x92\x30\x90\x28\xda\x12\x08\x7b\x7c\x13\xb3\xe0\x00\x07\xc1\x41\xd3\x97\xc6\xce\x76\x28\xeb\x3e\xe5\x6c\xdd\xa3\x04\x7d\x7c\xb7\x9d\xd4\x1e\xdf\x04\x64\xf5\x5f\x50\xa7\x16\x5b\x12\xd7\xfe\xe8\xcd\x58\x3d\xfe\x70\xc1\x70\x55\xa6\x8b\xee\xa2\x55\xec\x91\x5f\x40\xcc\x70\x62\x8f\x6f\xfa\x36\xbb\x9e\x29\x02\x7b\x7c\x37\x31\x55\x55\xc2\xee\xba\xb1\xd8\x4d\xc9\x12\x84\x23\x93\xbd\xc9\xc8\xba\x21\x4c\x3e\x61\xa1\x55\xcc\x37\x9d\x88\x01\x96\x38\x20\xe2\xe1\xc7\x3f\x13\xb2\x4c\x48\x8d\x4b\x81\x63\x62\x59\x70\x7a\x00\x41\x2b\xfc\x5f\x29\x55\xf6\xc8\xdc\x37\xa5\x77\x2d\x76\x8a\x25\xfe\x5f\x4c\xf3\x66\xd4\xca\x7e\x5d\xac\xbe\xc6\x40\xd4\x77\xec\x34\xb1\x5e\x3b\xbf\x6e\xf4\xe8\x58\x3f\x3c\x30\xee\xae\xf6\x96\x39\xae\x75\xe6\x7c\x39\x16\xba\xfe\xbf\xff\x81\x7c\x15\xab\x5a\x7b\xc1\x82\x7a\x14\x1c\xaa\xb7\x15\xa6\x9e\x11\x89\xeb\xf5\xc6\x42\x3c\x10\xbe\x47\xb6\xb3\x4f\x39\x54\x83\x41\x6f\x69\xe9\x1a\xa9\xcb\x8d\xf1\xd3\xf5\x4c\xcc\x81\x9b\x2a\xb7\xa8\x6a\x19\x26\x55\xaf\xc4\x3d\x6a\xce\xd6\x5a\xf9\x47\x34\xaa\x16\xdb\x57\x44\x7b\xa7\x8d\xd7\x0d\x8c\x37\x85\xe3\x1e\x7d\x6e\x0f\xc8\xb7\xc6\xd1\xff\x01\x06\x5b\xfb\x77\x55\x43\xcd\x5a\xba\x79\x36\xec\x5c\x37\xb4\x66\xc3\x57\xb8\xcd\xeb\x57\x68\xa8\xc3\x0d\xe9\xd3\xe9\xc7\x7d\xa3\xaa\xc4\x61\x73\xbf\xa0\x37\x13\x0e\x79\xfb\xf7\x58\xca\x7d\xc8\xef\x01\x6a\x95\xb0\x2f\x80\x86\x84\xc2\xe1\x8e\x48\xbc\x08\x01\x0e\x22\xdf\x5b\x11\x79\xe9\x72\x49\x9e\x0a\x2d\x34\x11\x8f\x84\x7e\xd1\xa8\xaa\x0d\x0d\x31\x8c\xfb\xf7\x20\x24\xc7\x92\xf1\x8e\x00\x7d\x51\xed\x53\x76\xdf\x2b\x1c\xb6\xa4\x70\x16\x15\x20\xe0\x10\xa8\xd4\x57\x12\xc6\x22\xc5\x9a\xaf\xd6\x36\xe9\x14\x7e\x94\xaa\xe3\xbc\x3b\xed\x6c\x4f\x6b\xd3\x5a\xdb\x51\x16\x40\x0e\x58\xc1\x22\x24\x27\x34\xdc\x35\x73\xc7\x77\xdd\x16\xfd\xb2\x29\xaf\x74\xf8\xeb\xb8\xb8\x35\xdb\x95\x6f\xd5\x7c\x64\xc6\xad\xb1\xd8\xdc\x8e\x56\xe1\xe1\x06\xbb\xe4\x88\xed\xf4\xa9\xb5\x21\x43\x34\xf0\x10\xb2\xee\x31\x0f\x1e\x31\x87\x39\x67\x4b\x12\x41\x5b\xa5\x62\xbc\x6f\x63\xdb\x1d\xee\xfb\x85\x97\x05\x66\x40\x76\xa7\xfc\x18\x87\x5a\x33\x6b\x77\x41\x68\xb0\xac\xd9\xce\x1e\xee\xde\xb7\xb6\xe9\xb6\xb7\xaf\xa3\xef\x7a\x51\x61\x62\x00\x10\xbf\x28\x83\xfc\xf7\x89\x52\xf5\x64\xd9\x47\x90\x9f\xd3\x05\x70\x0a\x12\xc4\xcf\x84\x06\xec\x51\x9c\xa8\x4a\x50\x7c\x5f\x51\xc7\x45\x34\xd1\xfa\x89\x8a\xe2\x20\x26\xf4\xab\xd0\xf4\xd4\xb6\x7c\x2c\x45\xe8\x34\x66\x09\xa8\x24\xcc\xb1\x10\x8f\x8c\x07\x9b\x24\x54\x34\x83\x11\x56\xb6\xd0\x7e\x40\x73\xeb\x94\x05\xf9\xd1\xa5\x6d\x06\x89\x71\x08\x5f\x60\x09\x1c\xa8\xdf\x66\x55\x6e\x5a\x2e\x81\xb7\x95\xcb\x8b\x64\x09\xd3\xa5\x22\x68\xdb\xa6\xb2\x5f\x5d\xa6\x88\xfb\xcd\xcc\xf3\x8a\xa8\x47\x80\x78\x48\x37\xb1\x7a\x0f\x69\x0f\xd3\x6a\xe0\xe0\xa5\x31\x96\x3d\xc0\x00\xd3\x80\x53\x59\x9d\xcf\xae\x5d\x34\xf2\xae\x09\x97\x49\x55\x6a\xcf\x39\x8b\x5d\x85\xa0\x2e\x0a\x21\xc7\xf2\xb1\x7f\x5f\x7c\x04\xb1\xbe\x00\x0e\x7e\xe6\x44\x82\xb5\xfd\xe8\xa4\x1e\xe7\x35\xeb\xb3\x63\x1f\x32\xa1\x6e\xd6\x5a\xe6\xab\x6d\x57\xf7\x41\xc7\x62\x84\xac\x94\x13\x5d\x19\x5e\xc5\xca\x41\xf9\x42\xab\x3d\xbf\xcd\x30\xff\x66\x86\xd8\x3d\x26\xd3\xad\xd3\xf9\x1f\xd1\xa8\x5a\xac\x39\x6a\x3b\xbd\x37\x1a\xe5\xd6\xf6\x78\x3c\x29\x3f\xb3\x9e\xd1\x20\x61\x84\x4a\x31\x59\x44\x6c\xe1\xd8\x45\xe0\xed\x3a\x5d\xef\x0a\x16\xaa\x22\x7a\xb2\xba\x37\x2b\xa4\x7a\x9a\xa3\x40\x9e\x7b\x14\xd0\xe4\xd2\x53\xb9\xad\x1a\xfa\xc7\x7f\xa1\xbf\x76\x92\x2f\xa8\x17\x55\x32\x64\x06\x79\xcf\xc9\x42\x6f\x75\xeb\x51\xab\x96\x6c\xb8\xc6\x5a\x11\x2e\x53\x1c\xcd\xf2\x3a\xa1\x7d\xe2\xd4\x1b\xfe\x4b\x6f\x92\xde\xee\xdd\x51\xcd\x7a\xd3\x2d\x1e\x03\xc8\xfc\xc6\xf1\xd2\x77\x46\xda\x6b\x78\xde\xd9\xa5\x47\xf0\x24\x81\xaa\xd4\x10\x0d\xf7\x6b\x96\x76\x64\x1f\xf9\x02\xec\x5d\x46\x70\xa3\x39\x77\x2c\xa9\xf9\x35\x73\x8b\x41\xc8\xf3\x39\x49\xe4\x59\x65\x58\x9b\xf0\x13\xa6\x41\x04\x5c\x8b\xd9\x0f\x93\x7f\xe8\x44\x38\x95\xec\x6b\x12\x72\x1c\xc0\x8c\x50\xa6\x51\x9a\x3f\xc8\xb0\x04\x48\x49\x68\x68\xde\x0a\xab\xa9\x82\x33\x09\xbe\x84\xc0\xd3\x08\xea\xe5\x3c\xd0\xe3\x18\xd3\xe0\x8a\x9d\x3d\x81\x9f\x4a\x03\x6c\x3b\x61\x8f\xc0\xc5\x3d\x44\xd1\x04\x9e\x00\x1d\x16\x34\x84\xd1\x39\x8b\x88\xff\x8c\xbe\x52\xae\x4e\x8b\x44\x6d\x80\x0e\x4b\x51\xe8\xd6\xb2\x1d\x64\xbf\xc7\x3c\x4c\x63\xa0\x52\xa0\xef\x91\x19\x93\x82\xd0\x30\x82\x9f\x52\x26\xc1\x1e\x3b\xf6\xe1\x2c\xff\x9a\xe4\xce\x91\xd1\xf7\x1e\xea\x01\xf3\x64\xee\x7a\xc0\x57\xc0\xdd\xb9\xa2\x47\x87\x6a\xf6\x9c\x52\xa1\x5e\x12\x1f\xdc\xa4\xcb\xa8\xaf\x16\x3c\xc5\x26\xe7\x3f\x4d\x2f\x8a\x48\x31\x79\x8a\x2f\xcc\xe7\xdf\x02\x5a\xc7\x91\x8d\x0e\x7f\x2c\x03\xda\xa4\x6d\xc2\x5c\xc9\xcd\xc7\xde\xcf\xf0\x6c\xd2\xf8\x11\x01\xd5\xd7\xf2\x9f\xc2\x7c\x86\xe7\x92\xf6\x97\x94\xc3\x27\x26\xa4\x0a\x6b\x93\x61\x28\x9a\x77\x0d\x66\xa5\xc9\xc9\xf4\x34\xdf\xd6\x0d\x4c\xd9\xa2\x40\x62\xce\x09\xf5\x49\x82\xa3\x8a\xca\x36\xd9\x3c\xf0\x39\xc8\x5d\x58\x0b\x4a\x7b\xec\x0c\x3a\x15\xd9\xe8\x9f\x2d\xaf\x97\x13\xba\x9e\x18\xd5\x59\xdf\x41\xf6\xad\x85\x7e\x40\xdf\x79\xff\xf6\xae\xce\x66\xd3\x2f\xee\xf5\xd9\x77\xb7\xb7\x39\x5c\x6a\x12\xbf\xbd\x6d\xce\x15\x1e\xc8\x34\x29\xd8\x27\x11\x0b\xd1\xdf\x7e\xf8\xcb\x07\xa3\x8d\xd5\x5d\x65\x84\x10\x42\xeb\xd1\x7f\x06\x00\x43\xb2\xe1\x5f\xef\x27\x00\x00")
 
 func kuberneteswinagentresourcesvmasTBytes() ([]byte, error) {
 	return bindataRead(
@@ -69,6 +69,44 @@ func NormalizeForVMSSScaling(logger *logrus.Entry, templateMap map[string]interf
 	return nil
 }
 
+// RemoveOtherAgentPoolScaleSets takes a template and removes the scale sets of the agent pools other than the
+// agent pools to preserve, so that scaling one agent pool does not redeploy the others
+func RemoveOtherAgentPoolScaleSets(logger *logrus.Entry, templateMap map[string]interface{}, agentPoolsToPreserve map[string]bool) error {
+	resources := templateMap[resourcesFieldName].([]interface{})
+	filteredResources := resources[:0]
+	for _, resource := range resources {
+		resourceMap, ok := resource.(map[string]interface{})
+		if !ok {
+			logger.Warnf("Template improperly formatted")
+			filteredResources = append(filteredResources, resource)
+			continue
+		}
+
+		resourceType, ok := resourceMap[typeFieldName].(string)
+		if !ok || resourceType != vmssResourceType {
+			filteredResources = append(filteredResources, resource)
+			continue
+		}
+
+		resourceName, ok := resourceMap[nameFieldName].(string)
+		if !ok {
+			logger.Warnf("Template improperly formatted")
+			filteredResources = append(filteredResources, resource)
+			continue
+		}
+
+		for agentPoolName := range agentPoolsToPreserve {
+			if strings.Contains(resourceName, fmt.Sprintf("variables('%sVMNamePrefix')", agentPoolName)) {
+				filteredResources = append(filteredResources, resource)
+				break
+			}
+		}
+	}
+
+	templateMap[resourcesFieldName] = filteredResources
+	return nil
+}
+
 // NormalizeForK8sVMASScalingUp takes a template and removes elements that are unwanted in a K8s VMAS scale up/down case
 func NormalizeForK8sVMASScalingUp(logger *logrus.Entry, templateMap map[string]interface{}) error {
 	if err := NormalizeMasterResourcesForScaling(logger, templateMap); err != nil {
@@ -256,3 +294,50 @@ func NormalizeResourcesForK8sMasterUpgrade(logger *logrus.Entry, templateMap map
 	}
 	return nil
 }
+
+// NormalizeResourcesForK8sAgentUpgrade takes a template and removes elements that are unwanted in a K8s agent upgrade case
+func NormalizeResourcesForK8sAgentUpgrade(logger *logrus.Entry, templateMap map[string]interface{}, agentPoolsToPreserve map[string]bool) error {
+	// an agent upgrade is a scale up of a single node, so the same NSG restrictions apply
+	if err := NormalizeForK8sVMASScalingUp(logger, templateMap); err != nil {
+		return err
+	}
+
+	resources := templateMap[resourcesFieldName].([]interface{})
+	filteredResources := resources[:0]
+	//remove master nodes resources, and agent nodes resources of the other agent pools
+	for _, resource := range resources {
+		resourceMap, ok := resource.(map[string]interface{})
+		if !ok {
+			logger.Warnf("Template improperly formatted")
+			filteredResources = append(filteredResources, resource)
+			continue
+		}
+
+		resourceType, ok := resourceMap[typeFieldName].(string)
+		if !ok || (resourceType != vmResourceType && resourceType != vmExtensionType) {
+			filteredResources = append(filteredResources, resource)
+			continue
+		}
+
+		resourceName, ok := resourceMap[nameFieldName].(string)
+		if !ok {
+			logger.Warnf("Template improperly formatted")
+			filteredResources = append(filteredResources, resource)
+			continue
+		}
+
+		if strings.Contains(resourceName, "variables('masterVMNamePrefix')") {
+			continue
+		}
+
+		for agentPoolName := range agentPoolsToPreserve {
+			if strings.Contains(resourceName, fmt.Sprintf("variables('%sVMNamePrefix')", agentPoolName)) {
+				filteredResources = append(filteredResources, resource)
+				break
+			}
+		}
+	}
+
+	templateMap[resourcesFieldName] = filteredResources
+	return nil
+}
@@ -4,43 +4,72 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"strings"
 
+	"github.com/Azure/acs-engine/pkg/api/common"
 	"github.com/Azure/acs-engine/pkg/api/v20160330"
 	"github.com/Azure/acs-engine/pkg/api/v20160930"
 	"github.com/Azure/acs-engine/pkg/api/v20170131"
 	"github.com/Azure/acs-engine/pkg/api/vlabs"
+	"github.com/ghodss/yaml"
 )
 
-// LoadContainerServiceFromFile loads an ACS Cluster API Model from a JSON file
-func LoadContainerServiceFromFile(jsonFile string) (*ContainerService, string, error) {
-	contents, e := ioutil.ReadFile(jsonFile)
+// LoadContainerServiceFromFile loads an ACS Cluster API Model from a JSON file, or a YAML file, see IsYAMLFile.
+// In strict mode, the fields of the file unknown to its API version are rejected.
+func LoadContainerServiceFromFile(file string, strict bool) (*ContainerService, string, error) {
+	contents, e := readAPIModelFile(file)
 	if e != nil {
-		return nil, "", fmt.Errorf("error reading file %s: %s", jsonFile, e.Error())
+		return nil, "", e
 	}
-	return DeserializeContainerService(contents)
+	return DeserializeContainerService(contents, strict)
+}
+
+// IsYAMLFile returns true if the file has a .yaml or .yml extension, and is loaded as YAML
+func IsYAMLFile(file string) bool {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// readAPIModelFile returns the contents of an api model file, converted to JSON if it is a YAML file
+func readAPIModelFile(file string) ([]byte, error) {
+	contents, e := ioutil.ReadFile(file)
+	if e != nil {
+		return nil, fmt.Errorf("error reading file %s: %s", file, e.Error())
+	}
+	if IsYAMLFile(file) {
+		if contents, e = yaml.YAMLToJSON(contents); e != nil {
+			return nil, fmt.Errorf("error parsing YAML file %s: %s", file, e.Error())
+		}
+	}
+	return contents, nil
 }
 
 // DeserializeContainerService loads an ACS Cluster API Model, validates it, and returns the unversioned representation
-func DeserializeContainerService(contents []byte) (*ContainerService, string, error) {
+func DeserializeContainerService(contents []byte, strict bool) (*ContainerService, string, error) {
 	m := &TypeMeta{}
 	if err := json.Unmarshal(contents, &m); err != nil {
 		return nil, "", err
 	}
 	version := m.APIVersion
-	service, err := LoadContainerService(contents, version)
+	service, err := LoadContainerService(contents, version, strict)
 
 	return service, version, err
 }
 
 // LoadContainerService loads an ACS Cluster API Model, validates it, and returns the unversioned representation
-func LoadContainerService(contents []byte, version string) (*ContainerService, error) {
+func LoadContainerService(contents []byte, version string, strict bool) (*ContainerService, error) {
 	switch version {
 	case v20160930.APIVersion:
 		containerService := &v20160930.ContainerService{}
 		if e := json.Unmarshal(contents, &containerService); e != nil {
 			return nil, e
 		}
-		if e := containerService.Properties.Validate(); e != nil {
+		if e := validate(contents, strict, &V20160930ARMContainerService{}, containerService.Properties.Validate()); e != nil {
 			return nil, e
 		}
 		return ConvertV20160930ContainerService(containerService), nil
@@ -50,7 +79,7 @@ func LoadContainerService(contents []byte, version string) (*ContainerService, e
 		if e := json.Unmarshal(contents, &containerService); e != nil {
 			return nil, e
 		}
-		if e := containerService.Properties.Validate(); e != nil {
+		if e := validate(contents, strict, &V20160330ARMContainerService{}, containerService.Properties.Validate()); e != nil {
 			return nil, e
 		}
 		return ConvertV20160330ContainerService(containerService), nil
@@ -60,7 +89,7 @@ func LoadContainerService(contents []byte, version string) (*ContainerService, e
 		if e := json.Unmarshal(contents, &containerService); e != nil {
 			return nil, e
 		}
-		if e := containerService.Properties.Validate(); e != nil {
+		if e := validate(contents, strict, &V20170131ARMContainerService{}, containerService.Properties.Validate()); e != nil {
 			return nil, e
 		}
 		return ConvertV20170131ContainerService(containerService), nil
@@ -70,7 +99,7 @@ func LoadContainerService(contents []byte, version string) (*ContainerService, e
 		if e := json.Unmarshal(contents, &containerService); e != nil {
 			return nil, e
 		}
-		if e := containerService.Properties.Validate(); e != nil {
+		if e := validate(contents, strict, &VlabsARMContainerService{}, containerService.Properties.Validate()); e != nil {
 			return nil, e
 		}
 		return ConvertVLabsContainerService(containerService), nil
@@ -131,3 +160,29 @@ func SerializeContainerService(containerService *ContainerService, version strin
 		return nil, fmt.Errorf("invalid version %s for conversion back from unversioned object", version)
 	}
 }
+
+// SerializeContainerServiceYAML takes an unversioned container service and returns the bytes of its YAML representation
+func SerializeContainerServiceYAML(containerService *ContainerService, version string) ([]byte, error) {
+	b, err := SerializeContainerService(containerService, version)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.JSONToYAML(b)
+}
+
+// validate returns the validation errors of an api model, preceded by its unknown fields in strict mode.
+// armContainerService is the type of the api model of the API version of the contents.
+func validate(contents []byte, strict bool, armContainerService interface{}, validationErr error) error {
+	var errs common.ValidationErrors
+	if strict {
+		errs = common.UnknownFields(contents, armContainerService)
+	}
+	if validationErr != nil {
+		validationErrs, ok := validationErr.(common.ValidationErrors)
+		if !ok {
+			return validationErr
+		}
+		errs = append(errs, validationErrs...)
+	}
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,2 @@
+// Package common contains definitions shared by the unversioned and the versioned API models
+package common
@@ -0,0 +1,144 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// JSONSchemaDraft is the JSON Schema specification the schemas follow
+const JSONSchemaDraft = "http://json-schema.org/draft-04/schema#"
+
+// Schema is a JSON Schema document, or the schema of one of its properties
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Minimum              *int               `json:"minimum,omitempty"`
+	Maximum              *int               `json:"maximum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+}
+
+// SchemaConstraints restrict the values of a field beyond its Go type
+type SchemaConstraints struct {
+	// Required fields must be present in their object
+	Required bool
+	Enum     []interface{}
+	Minimum  *int
+	Maximum  *int
+	// MinItems and MaxItems bound the length of an array
+	MinItems    *int
+	MaxItems    *int
+	UniqueItems bool
+	Pattern     string
+}
+
+// IntPtr returns a pointer to i, for the bounds of SchemaConstraints
+func IntPtr(i int) *int {
+	return &i
+}
+
+// NewJSONSchema returns the JSON Schema of the JSON representation of v. The objects allow other
+// properties than the fields of their types, since encoding/json matches the fields without regard to
+// case and the schema cannot; use strict mode to reject the unknown fields. constraints are keyed
+// by the path of the field they apply to, such as properties.agentPoolProfiles[].count, where [] stands
+// for the elements of an array and * for the values of a map. An error is returned if a constraint does
+// not match a field.
+func NewJSONSchema(v interface{}, title string, constraints map[string]SchemaConstraints) (*Schema, error) {
+	used := map[string]bool{}
+	schema := typeSchema(reflect.TypeOf(v), "", constraints, used, map[reflect.Type]bool{})
+	schema.Schema = JSONSchemaDraft
+	schema.Title = title
+
+	unused := []string{}
+	for path := range constraints {
+		if !used[path] {
+			unused = append(unused, path)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return nil, fmt.Errorf("the constraints of %v do not match any field of %s", unused, title)
+	}
+	return schema, nil
+}
+
+func typeSchema(t reflect.Type, path string, constraints map[string]SchemaConstraints, used map[string]bool, visiting map[reflect.Type]bool) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) {
+		// the JSON representation of the types that unmarshal themselves is unknown
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// json.Marshal encodes the byte slices as base64 strings
+			return &Schema{Type: "string"}
+		}
+		itemsPath := path + "[]"
+		items := typeSchema(t.Elem(), itemsPath, constraints, used, visiting)
+		applyConstraints(items, itemsPath, constraints, used)
+		return &Schema{Type: "array", Items: items}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: typeSchema(t.Elem(), path+".*", constraints, used, visiting)}
+	case reflect.Struct:
+		if visiting[t] {
+			return &Schema{Type: "object"}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		for name, fieldType := range jsonFields(t) {
+			fieldPath := joinPath(path, name)
+			fieldSchema := typeSchema(fieldType, fieldPath, constraints, used, visiting)
+			if applyConstraints(fieldSchema, fieldPath, constraints, used) {
+				schema.Required = append(schema.Required, name)
+			}
+			schema.Properties[name] = fieldSchema
+		}
+		sort.Strings(schema.Required)
+		return schema
+	default:
+		// interfaces accept any value
+		return &Schema{}
+	}
+}
+
+// applyConstraints adds the constraints of the field at path to its schema, and returns true if the field is required
+func applyConstraints(schema *Schema, path string, constraints map[string]SchemaConstraints, used map[string]bool) bool {
+	c, ok := constraints[path]
+	if !ok {
+		return false
+	}
+	used[path] = true
+	schema.Enum = c.Enum
+	schema.Minimum = c.Minimum
+	schema.Maximum = c.Maximum
+	schema.MinItems = c.MinItems
+	schema.MaxItems = c.MaxItems
+	schema.UniqueItems = c.UniqueItems
+	schema.Pattern = c.Pattern
+	return c.Required
+}
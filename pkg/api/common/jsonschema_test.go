@@ -0,0 +1,53 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_NewJSONSchema(t *testing.T) {
+	schema, err := NewJSONSchema(&testModel{}, "test", map[string]SchemaConstraints{
+		"apiVersion":                          {Required: true, Enum: []interface{}{"vlabs"}},
+		"properties.agentPoolProfiles":        {MaxItems: IntPtr(12)},
+		"properties.agentPoolProfiles[].name": {Required: true, Pattern: PoolNamePattern},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if schema.Schema != JSONSchemaDraft || schema.Title != "test" {
+		t.Errorf("unexpected $schema %q and title %q", schema.Schema, schema.Title)
+	}
+	if !reflect.DeepEqual(schema.Required, []string{"apiVersion"}) {
+		t.Errorf("unexpected required fields %v", schema.Required)
+	}
+	if schema.AdditionalProperties != nil {
+		t.Errorf("the objects should allow the fields matched without regard to case")
+	}
+
+	pools := schema.Properties["properties"].Properties["agentPoolProfiles"]
+	if pools.Type != "array" || pools.MaxItems == nil || *pools.MaxItems != 12 {
+		t.Errorf("unexpected agentPoolProfiles schema %+v", pools)
+	}
+	pool := pools.Items
+	if !reflect.DeepEqual(pool.Required, []string{"name"}) || pool.Properties["name"].Pattern != PoolNamePattern {
+		t.Errorf("unexpected agent pool schema %+v", pool)
+	}
+	if _, ok := pool.Properties["storageProfile"]; !ok {
+		t.Errorf("the agent pool schema should have the storageProfile property")
+	}
+
+	tags := schema.Properties["properties"].Properties["tags"]
+	if tags.Type != "object" || tags.AdditionalProperties.(*Schema).Type != "string" {
+		t.Errorf("unexpected tags schema %+v", tags)
+	}
+}
+
+func Test_NewJSONSchemaUnmatchedConstraint(t *testing.T) {
+	_, err := NewJSONSchema(&testModel{}, "test", map[string]SchemaConstraints{
+		"properties.agentPoolProfile[].name": {Required: true},
+	})
+	if err == nil {
+		t.Fatalf("expected an error for the constraint matching no field")
+	}
+}
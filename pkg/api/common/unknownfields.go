@@ -0,0 +1,249 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrorCodeUnknownField is returned by UnknownFields for a key that is not a field of the api model
+const ErrorCodeUnknownField = "UnknownField"
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// UnknownFields returns an error for each key of the JSON contents that does not match a field of v, the api model
+// type the contents are unmarshalled into, as json.Unmarshal silently ignores these keys. Like json.Unmarshal, the
+// keys are matched to the fields regardless of case. The errors suggest the field with the closest name, or where
+// the field is defined when it is misplaced.
+func UnknownFields(contents []byte, v interface{}) ValidationErrors {
+	var value interface{}
+	if err := json.Unmarshal(contents, &value); err != nil {
+		// the syntax errors are reported when the contents are unmarshalled into the api model
+		return nil
+	}
+
+	t := reflect.TypeOf(v)
+	definitions := map[string][]string{}
+	indexFields(t, "", definitions, map[reflect.Type]bool{})
+
+	var errs ValidationErrors
+	checkFields(value, t, "", definitions, &errs)
+	return errs
+}
+
+// jsonFields returns the types of the fields of the struct type t by their JSON names, the fields of embedded structs included
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" || (field.PkgPath != "" && !field.Anonymous) {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			for embeddedName, embeddedType := range jsonFields(fieldType) {
+				if _, ok := fields[embeddedName]; !ok {
+					fields[embeddedName] = embeddedType
+				}
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// structType returns the struct type the JSON objects of type t are unmarshalled into, and false for
+// the other types and for the types that unmarshal themselves
+func structType(t reflect.Type) (reflect.Type, bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || reflect.PtrTo(t).Implements(unmarshalerType) {
+		return nil, false
+	}
+	return t, true
+}
+
+// indexFields records the paths of the objects defining each field, by lower case field name
+func indexFields(t reflect.Type, path string, definitions map[string][]string, visiting map[reflect.Type]bool) {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			path += "[]"
+		}
+		t = t.Elem()
+	}
+	t, ok := structType(t)
+	if !ok || visiting[t] {
+		return
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	for name, fieldType := range jsonFields(t) {
+		key := strings.ToLower(name)
+		definitions[key] = append(definitions[key], displayPath(path))
+		indexFields(fieldType, joinPath(path, name), definitions, visiting)
+	}
+}
+
+func checkFields(value interface{}, t reflect.Type, path string, definitions map[string][]string, errs *ValidationErrors) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		if values, ok := value.([]interface{}); ok {
+			for i, v := range values {
+				checkFields(v, t.Elem(), IndexPath(path, i), definitions, errs)
+			}
+		}
+		return
+	case reflect.Map:
+		if values, ok := value.(map[string]interface{}); ok {
+			for _, key := range sortedKeys(values) {
+				checkFields(values[key], t.Elem(), joinPath(path, key), definitions, errs)
+			}
+		}
+		return
+	}
+
+	t, ok := structType(t)
+	if !ok {
+		return
+	}
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	fields := jsonFields(t)
+	for _, key := range sortedKeys(values) {
+		if fieldType, ok := matchField(key, fields); ok {
+			checkFields(values[key], fieldType, joinPath(path, key), definitions, errs)
+			continue
+		}
+
+		message := fmt.Sprintf("unknown field %q", key)
+		if suggestion := closestField(key, fields); suggestion != "" {
+			message += fmt.Sprintf(", did you mean %q?", suggestion)
+		} else if paths, ok := definitions[strings.ToLower(key)]; ok {
+			message += fmt.Sprintf(", it is a field of %s", strings.Join(uniqueStrings(paths), ", "))
+		}
+		errs.Add(joinPath(path, key), ErrorCodeUnknownField, "%s", message)
+	}
+}
+
+// matchField returns the type of the field key is unmarshalled into. As with json.Unmarshal, an exact
+// match is preferred to a match regardless of case.
+func matchField(key string, fields map[string]reflect.Type) (reflect.Type, bool) {
+	if fieldType, ok := fields[key]; ok {
+		return fieldType, true
+	}
+	for name, fieldType := range fields {
+		if strings.EqualFold(name, key) {
+			return fieldType, true
+		}
+	}
+	return nil, false
+}
+
+// closestField returns the name of the field closest to key, or "" when no field is close enough
+func closestField(key string, fields map[string]reflect.Type) string {
+	lowerKey := strings.ToLower(key)
+	best, bestDistance := "", len(key)
+	for name := range fields {
+		distance := levenshtein(lowerKey, strings.ToLower(name))
+		if distance < bestDistance || (distance == bestDistance && name < best) {
+			best, bestDistance = name, distance
+		}
+	}
+	maxDistance := len(key) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if bestDistance > maxDistance {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the number of single character edits turning a into b
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "the top level object"
+	}
+	return path
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func uniqueStrings(values []string) []string {
+	unique := []string{}
+	seen := map[string]bool{}
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"testing"
+)
+
+type testMeta struct {
+	APIVersion string `json:"apiVersion"`
+}
+
+type testPool struct {
+	Name           string `json:"name"`
+	VMSize         string `json:"vmSize"`
+	StorageProfile string `json:"storageProfile,omitempty"`
+}
+
+type testModel struct {
+	testMeta
+	Properties *struct {
+		MasterProfile *struct {
+			VMSize string `json:"vmSize"`
+		} `json:"masterProfile,omitempty"`
+		AgentPoolProfiles []*testPool       `json:"agentPoolProfiles,omitempty"`
+		Tags              map[string]string `json:"tags,omitempty"`
+	} `json:"properties"`
+}
+
+func Test_UnknownFields(t *testing.T) {
+	contents := []byte(`{
+		"apiVersion": "vlabs",
+		"properties": {
+			"masterProfile": {"vmsize": "Standard_D2_v2"},
+			"agentPoolProfile": [],
+			"agentPoolProfiles": [
+				{"name": "pool1", "vmSize": "Standard_D2_v2"},
+				{"name": "pool2", "vmSize": "Standard_D2_v2", "storageProfil": "ManagedDisks"}
+			],
+			"tags": {"anything": "goes"},
+			"name": "cluster"
+		}
+	}`)
+
+	errs := UnknownFields(contents, &testModel{})
+	expected := []ValidationError{
+		{Path: "properties.agentPoolProfile", Message: `unknown field "agentPoolProfile", did you mean "agentPoolProfiles"?`},
+		{Path: "properties.agentPoolProfiles[1].storageProfil", Message: `unknown field "storageProfil", did you mean "storageProfile"?`},
+		{Path: "properties.name", Message: `unknown field "name", it is a field of properties.agentPoolProfiles[]`},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, e := range expected {
+		if errs[i].Path != e.Path || errs[i].Message != e.Message || errs[i].Code != ErrorCodeUnknownField {
+			t.Errorf("expected error %d to be %s: %s, got %s: %s", i, e.Path, e.Message, errs[i].Path, errs[i].Message)
+		}
+	}
+}
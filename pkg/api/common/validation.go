@@ -0,0 +1,80 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// The codes of the validation errors. They are stable, so that clients of the API can match them.
+const (
+	// ErrorCodeRequired is returned when a required field is missing or empty
+	ErrorCodeRequired = "Required"
+	// ErrorCodeMustBeEmpty is returned when a field is set but is not allowed in this context
+	ErrorCodeMustBeEmpty = "MustBeEmpty"
+	// ErrorCodeInvalidValue is returned when a field is not one of its allowed values
+	ErrorCodeInvalidValue = "InvalidValue"
+	// ErrorCodeInvalidFormat is returned when a field does not have the expected format, such as a DNS name or a subnet
+	ErrorCodeInvalidFormat = "InvalidFormat"
+	// ErrorCodeOutOfRange is returned when a number or a count is outside of its allowed range
+	ErrorCodeOutOfRange = "OutOfRange"
+	// ErrorCodeDuplicate is returned when a value must be unique but is repeated
+	ErrorCodeDuplicate = "Duplicate"
+	// ErrorCodeUnsupported is returned when a field is valid on its own but not supported with the rest of the api model
+	ErrorCodeUnsupported = "Unsupported"
+	// ErrorCodeInconsistent is returned when fields that must agree with each other do not
+	ErrorCodeInconsistent = "Inconsistent"
+)
+
+const (
+	// DNSPrefixPattern is the regular expression the DNS prefixes must match, between 3 and 45 letters,
+	// numbers and hyphens, starting with a letter and ending with a letter or a number
+	DNSPrefixPattern = `^([A-Za-z][A-Za-z0-9-]{1,43}[A-Za-z0-9])$`
+	// PoolNamePattern is the regular expression the agent pool names must match. The pool names are part
+	// of the VM names, they are capped at 12 lower case letters and numbers.
+	PoolNamePattern = `^([a-z][a-z0-9]{0,11})$`
+)
+
+// ValidationError is a problem found in an api model
+type ValidationError struct {
+	// Path is the JSON path of the field, such as properties.agentPoolProfiles[2].vmSize
+	Path    string `json:"path"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationErrors are all the problems found in an api model
+type ValidationErrors []*ValidationError
+
+func (errs ValidationErrors) Error() string {
+	if len(errs) == 1 {
+		return errs[0].Error()
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d errors found in the api model:", len(errs))
+	for _, e := range errs {
+		fmt.Fprintf(&b, "\n  %s", e.Error())
+	}
+	return b.String()
+}
+
+// Add records a problem with the field at path
+func (errs *ValidationErrors) Add(path, code, format string, args ...interface{}) {
+	*errs = append(*errs, &ValidationError{Path: path, Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+// ErrorOrNil returns the errors, or nil when there is none. A nil ValidationErrors is not a nil error.
+func (errs ValidationErrors) ErrorOrNil() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// IndexPath returns the path of the element i of the array at path
+func IndexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
@@ -0,0 +1,77 @@
+package common
+
+import "sort"
+
+// upgradePaths maps an orchestrator type to the versions a cluster can be upgraded from, and
+// each of those versions to the versions the cluster can be upgraded to. The api package
+// registers its upgrade paths.
+var upgradePaths = map[string]map[string][]string{}
+
+// RegisterUpgradePath allows the clusters of the specified orchestrator type to be upgraded
+// from fromVersion to toVersion
+func RegisterUpgradePath(orchestratorType, fromVersion, toVersion string) {
+	if upgradePaths[orchestratorType] == nil {
+		upgradePaths[orchestratorType] = map[string][]string{}
+	}
+	if !IsUpgradeSupported(orchestratorType, fromVersion, toVersion) {
+		upgradePaths[orchestratorType][fromVersion] = append(upgradePaths[orchestratorType][fromVersion], toVersion)
+	}
+}
+
+// IsUpgradeSupported returns true if a cluster of the specified orchestrator type can be upgraded
+// from fromVersion to toVersion
+func IsUpgradeSupported(orchestratorType, fromVersion, toVersion string) bool {
+	for _, version := range GetUpgradeVersions(orchestratorType, fromVersion) {
+		if version == toVersion {
+			return true
+		}
+	}
+	return false
+}
+
+// GetUpgradeVersions returns the versions a cluster of the specified orchestrator type and version can be upgraded to
+func GetUpgradeVersions(orchestratorType, fromVersion string) []string {
+	return upgradePaths[orchestratorType][fromVersion]
+}
+
+// IsUpgradeTargetVersion returns true if a cluster of the specified orchestrator type can be upgraded
+// to toVersion from at least one version
+func IsUpgradeTargetVersion(orchestratorType, toVersion string) bool {
+	for fromVersion := range upgradePaths[orchestratorType] {
+		if IsUpgradeSupported(orchestratorType, fromVersion, toVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetUpgradeOrchestratorTypes returns the orchestrator types of the clusters that can be upgraded, sorted
+func GetUpgradeOrchestratorTypes() []string {
+	orchestratorTypes := []string{}
+	for orchestratorType := range upgradePaths {
+		orchestratorTypes = append(orchestratorTypes, orchestratorType)
+	}
+	sort.Strings(orchestratorTypes)
+	return orchestratorTypes
+}
+
+// GetUpgradeTargetVersions returns the versions clusters of the specified orchestrator type can be upgraded to, sorted
+func GetUpgradeTargetVersions(orchestratorType string) []string {
+	targetVersions := []string{}
+	seen := map[string]bool{}
+	for _, toVersions := range upgradePaths[orchestratorType] {
+		for _, toVersion := range toVersions {
+			if !seen[toVersion] {
+				seen[toVersion] = true
+				targetVersions = append(targetVersions, toVersion)
+			}
+		}
+	}
+	sort.Strings(targetVersions)
+	return targetVersions
+}
+
+// IsUpgradeSupportedForOrchestrator returns true if clusters of the specified orchestrator type can be upgraded
+func IsUpgradeSupportedForOrchestrator(orchestratorType string) bool {
+	return len(upgradePaths[orchestratorType]) > 0
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"testing"
+)
+
+func init() {
+	RegisterUpgradePath("Test", "1.0.0", "1.1.0")
+	RegisterUpgradePath("Test", "1.0.0", "1.2.0")
+	RegisterUpgradePath("Test", "1.1.0", "1.2.0")
+}
+
+func Test_IsUpgradeSupported(t *testing.T) {
+	if !IsUpgradeSupported("Test", "1.0.0", "1.2.0") {
+		t.Errorf("expected Test upgrade from 1.0.0 to 1.2.0 to be supported")
+	}
+	if IsUpgradeSupported("Test", "1.2.0", "1.0.0") {
+		t.Errorf("expected Test downgrade from 1.2.0 to 1.0.0 to not be supported")
+	}
+	if IsUpgradeSupported("DCOS", "1.8.8", "1.9.0") {
+		t.Errorf("expected DCOS upgrade to not be supported")
+	}
+}
+
+func Test_IsUpgradeTargetVersion(t *testing.T) {
+	if !IsUpgradeTargetVersion("Test", "1.1.0") {
+		t.Errorf("expected Test 1.1.0 to be an upgrade target")
+	}
+	if IsUpgradeTargetVersion("Test", "1.0.0") {
+		t.Errorf("expected Test 1.0.0 to not be an upgrade target")
+	}
+	if versions := GetUpgradeTargetVersions("Test"); len(versions) != 2 {
+		t.Errorf("expected the registered upgrade paths to be deduplicated, got %v", versions)
+	}
+}
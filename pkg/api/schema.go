@@ -0,0 +1,160 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
+	"github.com/Azure/acs-engine/pkg/api/v20160330"
+	"github.com/Azure/acs-engine/pkg/api/v20160930"
+	"github.com/Azure/acs-engine/pkg/api/v20170131"
+	"github.com/Azure/acs-engine/pkg/api/vlabs"
+)
+
+// APIVersions are the API versions of the api models, oldest first
+var APIVersions = []string{v20160330.APIVersion, v20160930.APIVersion, v20170131.APIVersion, vlabs.APIVersion}
+
+// UpgradeAPIVersions are the API versions of the upgrade models
+var UpgradeAPIVersions = []string{vlabs.APIVersion}
+
+// optionalDNSPrefixPattern matches the DNS prefixes of the agent pools, which may be empty
+const optionalDNSPrefixPattern = "^$|" + common.DNSPrefixPattern
+
+// GetJSONSchema returns the JSON Schema of the api model of an API version
+func GetJSONSchema(version string) (*common.Schema, error) {
+	title := fmt.Sprintf("acs-engine api model, apiVersion %s", version)
+
+	switch version {
+	case v20160930.APIVersion:
+		constraints := containerServiceConstraints(version, v20160930.MinAgentCount, v20160930.MaxAgentCount,
+			v20160930.Mesos, v20160930.DCOS, v20160930.Swarm, v20160930.Kubernetes)
+		addOSTypeConstraints(constraints, "properties.jumpboxProfile.osType", v20160930.Linux, v20160930.Windows)
+		return common.NewJSONSchema(&V20160930ARMContainerService{}, title, constraints)
+
+	case v20160330.APIVersion:
+		constraints := containerServiceConstraints(version, v20160330.MinAgentCount, v20160330.MaxAgentCount,
+			v20160330.Mesos, v20160330.DCOS, v20160330.Swarm)
+		addOSTypeConstraints(constraints, "properties.jumpboxProfile.osType", v20160330.Linux, v20160330.Windows)
+		return common.NewJSONSchema(&V20160330ARMContainerService{}, title, constraints)
+
+	case v20170131.APIVersion:
+		constraints := containerServiceConstraints(version, v20170131.MinAgentCount, v20170131.MaxAgentCount,
+			v20170131.Mesos, v20170131.DCOS, v20170131.Swarm, v20170131.Kubernetes)
+		addOSTypeConstraints(constraints, "properties.jumpboxProfile.osType", v20170131.Linux, v20170131.Windows)
+		return common.NewJSONSchema(&V20170131ARMContainerService{}, title, constraints)
+
+	case vlabs.APIVersion:
+		constraints := containerServiceConstraints(version, vlabs.MinAgentCount, vlabs.MaxAgentCount,
+			vlabs.DCOS, vlabs.Swarm, vlabs.Kubernetes, vlabs.SwarmMode)
+		addVLabsConstraints(constraints)
+		return common.NewJSONSchema(&VlabsARMContainerService{}, title, constraints)
+
+	default:
+		return nil, fmt.Errorf("unrecognized APIVersion '%s'", version)
+	}
+}
+
+// GetUpgradeJSONSchema returns the JSON Schema of the upgrade model of an API version
+func GetUpgradeJSONSchema(version string) (*common.Schema, error) {
+	title := fmt.Sprintf("acs-engine upgrade model, apiVersion %s", version)
+
+	switch version {
+	case vlabs.APIVersion:
+		orchestratorTypes := []interface{}{}
+		orchestratorVersions := []interface{}{}
+		for _, orchestratorType := range common.GetUpgradeOrchestratorTypes() {
+			orchestratorTypes = append(orchestratorTypes, orchestratorType)
+			for _, orchestratorVersion := range common.GetUpgradeTargetVersions(orchestratorType) {
+				orchestratorVersions = append(orchestratorVersions, orchestratorVersion)
+			}
+		}
+
+		constraints := map[string]common.SchemaConstraints{
+			"apiVersion":                                         {Required: true, Enum: []interface{}{version}},
+			"orchestratorProfile":                                {Required: true},
+			"orchestratorProfile.orchestratorType":               {Required: true, Enum: orchestratorTypes},
+			"orchestratorProfile.orchestratorVersion":            {Required: true, Enum: orchestratorVersions},
+			"orchestratorProfile.kubernetesConfig.networkPolicy": {Enum: networkPolicies()},
+		}
+		return common.NewJSONSchema(&VlabsUpgradeContainerService{}, title, constraints)
+
+	default:
+		return nil, fmt.Errorf("unrecognized APIVersion '%s'", version)
+	}
+}
+
+// containerServiceConstraints returns the constraints shared by the api models of all the API versions.
+// The orchestrator types are those of the API version.
+func containerServiceConstraints(version string, minAgentCount, maxAgentCount int, orchestratorTypes ...interface{}) map[string]common.SchemaConstraints {
+	constraints := map[string]common.SchemaConstraints{
+		"apiVersion":                     {Required: true, Enum: []interface{}{version}},
+		"properties":                     {Required: true},
+		"properties.orchestratorProfile": {Required: true},
+		"properties.orchestratorProfile.orchestratorType":  {Required: true, Enum: orchestratorTypes},
+		"properties.masterProfile":                         {Required: true},
+		"properties.masterProfile.count":                   {Required: true, Enum: []interface{}{1, 3, 5}},
+		"properties.masterProfile.dnsPrefix":               {Required: true, Pattern: common.DNSPrefixPattern},
+		"properties.agentPoolProfiles[].name":              {Required: true, Pattern: common.PoolNamePattern},
+		"properties.agentPoolProfiles[].count":             {Required: true, Minimum: common.IntPtr(minAgentCount), Maximum: common.IntPtr(maxAgentCount)},
+		"properties.agentPoolProfiles[].vmSize":            {Required: true},
+		"properties.agentPoolProfiles[].dnsPrefix":         {Pattern: optionalDNSPrefixPattern},
+		"properties.linuxProfile":                          {Required: true},
+		"properties.linuxProfile.adminUsername":            {Required: true},
+		"properties.linuxProfile.ssh":                      {Required: true},
+		"properties.linuxProfile.ssh.publicKeys":           {Required: true, MinItems: common.IntPtr(1), MaxItems: common.IntPtr(1)},
+		"properties.linuxProfile.ssh.publicKeys[].keyData": {Required: true},
+	}
+	addOSTypeConstraints(constraints, "properties.agentPoolProfiles[].osType", "Linux", "Windows")
+	return constraints
+}
+
+// addOSTypeConstraints restricts the field at path to the OS types. An empty OS type is Linux.
+func addOSTypeConstraints(constraints map[string]common.SchemaConstraints, path string, osTypes ...interface{}) {
+	constraints[path] = common.SchemaConstraints{Enum: append([]interface{}{""}, osTypes...)}
+}
+
+// addVLabsConstraints adds the constraints of the fields only vlabs has
+func addVLabsConstraints(constraints map[string]common.SchemaConstraints) {
+	orchestratorVersions := []interface{}{""}
+	for _, orchestratorVersion := range []vlabs.OrchestratorVersion{
+		vlabs.DCOS173, vlabs.DCOS184, vlabs.DCOS187, vlabs.DCOS188, vlabs.DCOS190,
+		vlabs.Kubernetes153, vlabs.Kubernetes157, vlabs.Kubernetes160, vlabs.Kubernetes162,
+	} {
+		orchestratorVersions = append(orchestratorVersions, orchestratorVersion)
+	}
+	diskSize := common.SchemaConstraints{Minimum: common.IntPtr(vlabs.MinDiskSizeGB), Maximum: common.IntPtr(vlabs.MaxDiskSizeGB)}
+	// 0 leaves the OS disk size and the IP address count of the profiles to their default
+	osDiskSize := common.SchemaConstraints{Minimum: common.IntPtr(0), Maximum: common.IntPtr(vlabs.MaxDiskSizeGB)}
+	ipAddressCount := common.SchemaConstraints{Minimum: common.IntPtr(0), Maximum: common.IntPtr(vlabs.MaxIPAddressCount)}
+	storageProfiles := []interface{}{"", vlabs.StorageAccount, vlabs.ManagedDisks}
+
+	for path, c := range map[string]common.SchemaConstraints{
+		"properties.orchestratorProfile.orchestratorVersion":                       {Enum: orchestratorVersions},
+		"properties.orchestratorProfile.kubernetesConfig.networkPolicy":            {Enum: networkPolicies()},
+		"properties.masterProfile.vmSize":                                          {Required: true},
+		"properties.masterProfile.osDiskSizeGB":                                    osDiskSize,
+		"properties.masterProfile.ipAddressCount":                                  ipAddressCount,
+		"properties.masterProfile.storageProfile":                                  {Enum: storageProfiles},
+		"properties.agentPoolProfiles[].osDiskSizeGB":                              osDiskSize,
+		"properties.agentPoolProfiles[].ipAddressCount":                            ipAddressCount,
+		"properties.agentPoolProfiles[].storageProfile":                            {Enum: storageProfiles},
+		"properties.agentPoolProfiles[].availabilityProfile":                       {Enum: []interface{}{"", vlabs.AvailabilitySet, vlabs.VirtualMachineScaleSets}},
+		"properties.agentPoolProfiles[].diskSizesGB":                               {MaxItems: common.IntPtr(vlabs.MaxDisks)},
+		"properties.agentPoolProfiles[].diskSizesGB[]":                             diskSize,
+		"properties.agentPoolProfiles[].ports":                                     {UniqueItems: true},
+		"properties.agentPoolProfiles[].ports[]":                                   {Minimum: common.IntPtr(vlabs.MinPort), Maximum: common.IntPtr(vlabs.MaxPort)},
+		"properties.windowsProfile.secrets[].vaultCertificates":                    {Required: true, MinItems: common.IntPtr(1)},
+		"properties.windowsProfile.secrets[].vaultCertificates[].certificateStore": {Required: true},
+		"properties.linuxProfile.secrets[].vaultCertificates":                      {Required: true, MinItems: common.IntPtr(1)},
+	} {
+		constraints[path] = c
+	}
+}
+
+// networkPolicies returns the network policies of the Kubernetes clusters, an empty policy is none
+func networkPolicies() []interface{} {
+	policies := []interface{}{}
+	for _, policy := range vlabs.NetworkPolicyValues {
+		policies = append(policies, policy)
+	}
+	return policies
+}
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"testing"
+)
+
+func TestGetJSONSchema(t *testing.T) {
+	for _, version := range APIVersions {
+		schema, err := GetJSONSchema(version)
+		if err != nil {
+			t.Fatalf("unexpected error getting the schema of %s: %s", version, err)
+		}
+		if schema.Properties["properties"] == nil {
+			t.Errorf("the schema of %s does not have the properties field", version)
+		}
+	}
+	for _, version := range UpgradeAPIVersions {
+		if _, err := GetUpgradeJSONSchema(version); err != nil {
+			t.Fatalf("unexpected error getting the upgrade schema of %s: %s", version, err)
+		}
+	}
+
+	if _, err := GetJSONSchema("2000-01-01"); err == nil {
+		t.Errorf("expected an error for an unknown API version")
+	}
+
+	// 0 uses the default OS disk size, the schema must accept it like the validation does
+	schema, err := GetJSONSchema("vlabs")
+	if err != nil {
+		t.Fatalf("unexpected error getting the schema of vlabs: %s", err)
+	}
+	osDiskSizeGB := schema.Properties["properties"].Properties["masterProfile"].Properties["osDiskSizeGB"]
+	if osDiskSizeGB == nil || osDiskSizeGB.Minimum == nil || *osDiskSizeGB.Minimum != 0 {
+		t.Errorf("expected the vlabs masterProfile.osDiskSizeGB minimum to be 0, got %v", osDiskSizeGB)
+	}
+}
@@ -3,41 +3,41 @@ package api
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 
 	"github.com/Azure/acs-engine/pkg/api/vlabs"
 )
 
-// LoadUpgradeContainerServiceFromFile loads an ACS Cluster API Model from a JSON file
-func LoadUpgradeContainerServiceFromFile(jsonFile string) (*UpgradeContainerService, string, error) {
-	contents, e := ioutil.ReadFile(jsonFile)
+// LoadUpgradeContainerServiceFromFile loads an ACS Cluster API Model from a JSON file, or a YAML file, see IsYAMLFile.
+// In strict mode, the fields of the file unknown to its API version are rejected.
+func LoadUpgradeContainerServiceFromFile(file string, strict bool) (*UpgradeContainerService, string, error) {
+	contents, e := readAPIModelFile(file)
 	if e != nil {
-		return nil, "", fmt.Errorf("error reading file %s: %s", jsonFile, e.Error())
+		return nil, "", e
 	}
-	return DeserializeUpgradeContainerService(contents)
+	return DeserializeUpgradeContainerService(contents, strict)
 }
 
 // DeserializeUpgradeContainerService loads an ACS Cluster API Model, validates it, and returns the unversioned representation
-func DeserializeUpgradeContainerService(contents []byte) (*UpgradeContainerService, string, error) {
+func DeserializeUpgradeContainerService(contents []byte, strict bool) (*UpgradeContainerService, string, error) {
 	m := &TypeMeta{}
 	if err := json.Unmarshal(contents, &m); err != nil {
 		return nil, "", err
 	}
 	version := m.APIVersion
-	upgradecontainerservice, err := LoadUpgradeContainerService(contents, version)
+	upgradecontainerservice, err := LoadUpgradeContainerService(contents, version, strict)
 
 	return upgradecontainerservice, version, err
 }
 
 // LoadUpgradeContainerService loads an ACS Cluster API Model, validates it, and returns the unversioned representation
-func LoadUpgradeContainerService(contents []byte, version string) (*UpgradeContainerService, error) {
+func LoadUpgradeContainerService(contents []byte, version string, strict bool) (*UpgradeContainerService, error) {
 	switch version {
 	case vlabs.APIVersion:
 		upgradecontainerService := &vlabs.UpgradeContainerService{}
 		if e := json.Unmarshal(contents, &upgradecontainerService); e != nil {
 			return nil, e
 		}
-		if e := upgradecontainerService.Validate(); e != nil {
+		if e := validate(contents, strict, &VlabsUpgradeContainerService{}, upgradecontainerService.Validate()); e != nil {
 			return nil, e
 		}
 		return ConvertVLabsUpgradeContainerService(upgradecontainerService), nil
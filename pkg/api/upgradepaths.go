@@ -0,0 +1,26 @@
+package api
+
+import "github.com/Azure/acs-engine/pkg/api/common"
+
+// UpgradePath is a version transition the clusters of an orchestrator can be upgraded through
+type UpgradePath struct {
+	OrchestratorType OrchestratorType
+	FromVersion      OrchestratorVersion
+	ToVersion        OrchestratorVersion
+}
+
+// UpgradePaths are the supported upgrade paths. They are registered in the api/common package,
+// which the versioned upgrade models are validated against.
+var UpgradePaths = []UpgradePath{
+	{Kubernetes, Kubernetes153, Kubernetes160},
+	{Kubernetes, Kubernetes153, Kubernetes162},
+	{Kubernetes, Kubernetes157, Kubernetes160},
+	{Kubernetes, Kubernetes157, Kubernetes162},
+	{Kubernetes, Kubernetes160, Kubernetes162},
+}
+
+func init() {
+	for _, path := range UpgradePaths {
+		common.RegisterUpgradePath(string(path.OrchestratorType), string(path.FromVersion), string(path.ToVersion))
+	}
+}
@@ -1,161 +1,197 @@
 package v20160330
 
 import (
-	"errors"
-	"fmt"
 	"regexp"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 // Validate implements APIObject
 func (o *OrchestratorProfile) Validate() error {
+	return o.validate("orchestratorProfile").ErrorOrNil()
+}
+
+func (o *OrchestratorProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
 	switch o.OrchestratorType {
 	case DCOS:
 	case Mesos:
 	case Swarm:
 	default:
-		return fmt.Errorf("OrchestratorProfile has unknown orchestrator: %s", o.OrchestratorType)
+		errs.Add(path+".orchestratorType", common.ErrorCodeInvalidValue, "unknown orchestrator: %s", o.OrchestratorType)
 	}
 
-	return nil
+	return errs
 }
 
 // Validate implements APIObject
 func (m *MasterProfile) Validate() error {
+	return m.validate("masterProfile").ErrorOrNil()
+}
+
+func (m *MasterProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
 	if m.Count != 1 && m.Count != 3 && m.Count != 5 {
-		return fmt.Errorf("MasterProfile count needs to be 1, 3, or 5")
-	}
-	if e := validateName(m.DNSPrefix, "MasterProfile.DNSPrefix"); e != nil {
-		return e
-	}
-	if e := validateDNSName(m.DNSPrefix); e != nil {
-		return e
+		errs.Add(path+".count", common.ErrorCodeInvalidValue, "MasterProfile count needs to be 1, 3, or 5")
 	}
-	return nil
+	errs = append(errs, validateDNSName(path+".dnsPrefix", m.DNSPrefix)...)
+	return errs
 }
 
 // Validate implements APIObject
 func (a *AgentPoolProfile) Validate() error {
-	if e := validateName(a.Name, "AgentPoolProfile.Name"); e != nil {
-		return e
-	}
-	if e := validatePoolName(a.Name); e != nil {
-		return e
+	return a.validate("agentPoolProfile").ErrorOrNil()
+}
+
+func (a *AgentPoolProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
+	if a.Name == "" {
+		errs = append(errs, validateName(path+".name", a.Name)...)
+	} else {
+		errs = append(errs, validatePoolName(path+".name", a.Name)...)
 	}
 	if a.Count < MinAgentCount || a.Count > MaxAgentCount {
-		return fmt.Errorf("AgentPoolProfile count needs to be in the range [%d,%d]", MinAgentCount, MaxAgentCount)
-	}
-	if e := validateName(a.VMSize, "AgentPoolProfile.VMSize"); e != nil {
-		return e
+		errs.Add(path+".count", common.ErrorCodeOutOfRange, "AgentPoolProfile count needs to be in the range [%d,%d]", MinAgentCount, MaxAgentCount)
 	}
+	errs = append(errs, validateName(path+".vmSize", a.VMSize)...)
 	if a.DNSPrefix != "" {
-		if e := validateDNSName(a.DNSPrefix); e != nil {
-			return e
-		}
+		errs = append(errs, validateDNSName(path+".dnsPrefix", a.DNSPrefix)...)
 	}
-	return nil
+	return errs
 }
 
 // Validate implements APIObject
 func (l *LinuxProfile) Validate() error {
-	if e := validateName(l.AdminUsername, "LinuxProfile.AdminUsername"); e != nil {
-		return e
-	}
+	return l.validate("linuxProfile").ErrorOrNil()
+}
+
+func (l *LinuxProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
+	errs = append(errs, validateName(path+".adminUsername", l.AdminUsername)...)
 	if len(l.SSH.PublicKeys) != 1 {
-		return errors.New("LinuxProfile.PublicKeys requires only 1 SSH Key")
+		errs.Add(path+".ssh.publicKeys", common.ErrorCodeInvalidValue, "LinuxProfile.PublicKeys requires only 1 SSH Key")
+	} else {
+		errs = append(errs, validateName(path+".ssh.publicKeys[0].keyData", l.SSH.PublicKeys[0].KeyData)...)
 	}
-	if e := validateName(l.SSH.PublicKeys[0].KeyData, "LinuxProfile.PublicKeys.KeyData"); e != nil {
-		return e
-	}
-	return nil
+	return errs
 }
 
-// Validate implements APIObject
+// Validate implements APIObject. The error is a common.ValidationErrors listing every problem found.
 func (a *Properties) Validate() error {
-	if e := a.OrchestratorProfile.Validate(); e != nil {
-		return e
-	}
-	if e := a.MasterProfile.Validate(); e != nil {
-		return e
-	}
-	if e := validateUniqueProfileNames(a.AgentPoolProfiles); e != nil {
-		return e
+	var errs common.ValidationErrors
+
+	if a == nil {
+		errs.Add("properties", common.ErrorCodeRequired, "Properties is required")
+		return errs
 	}
 
-	for _, agentPoolProfile := range a.AgentPoolProfiles {
-		if e := agentPoolProfile.Validate(); e != nil {
-			return e
-		}
+	var orchestratorType OrchestratorType
+	if a.OrchestratorProfile == nil {
+		errs.Add("properties.orchestratorProfile", common.ErrorCodeRequired, "OrchestratorProfile is required")
+	} else {
+		orchestratorType = a.OrchestratorProfile.OrchestratorType
+		errs = append(errs, a.OrchestratorProfile.validate("properties.orchestratorProfile")...)
+	}
+	if a.MasterProfile == nil {
+		errs.Add("properties.masterProfile", common.ErrorCodeRequired, "MasterProfile is required")
+	} else {
+		errs = append(errs, a.MasterProfile.validate("properties.masterProfile")...)
+	}
+	errs = append(errs, validateUniqueProfileNames("properties.agentPoolProfiles", a.AgentPoolProfiles)...)
+
+	windowsProfileChecked := false
+	for i, agentPoolProfile := range a.AgentPoolProfiles {
+		path := common.IndexPath("properties.agentPoolProfiles", i)
+		errs = append(errs, agentPoolProfile.validate(path)...)
+
 		if agentPoolProfile.OSType == Windows {
-			switch a.OrchestratorProfile.OrchestratorType {
+			switch orchestratorType {
 			case Swarm:
 			default:
-				return fmt.Errorf("Orchestrator %s does not support Windows", a.OrchestratorProfile.OrchestratorType)
+				errs.Add(path+".osType", common.ErrorCodeUnsupported, "Orchestrator %s does not support Windows", orchestratorType)
+			}
+
+			// the WindowsProfile is shared by the Windows pools, its problems are only reported once
+			if windowsProfileChecked {
+				continue
+			}
+			windowsProfileChecked = true
+			if a.WindowsProfile == nil {
+				errs.Add("properties.windowsProfile", common.ErrorCodeRequired, "WindowsProfile must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
+				continue
 			}
 			if len(a.WindowsProfile.AdminUsername) == 0 {
-				return fmt.Errorf("WindowsProfile.AdminUsername must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
+				errs.Add("properties.windowsProfile.adminUsername", common.ErrorCodeRequired, "WindowsProfile.AdminUsername must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
 			}
 			if len(a.WindowsProfile.AdminPassword) == 0 {
-				return fmt.Errorf("WindowsProfile.AdminPassword must not be empty since  agent pool '%s' specifies windows", agentPoolProfile.Name)
+				errs.Add("properties.windowsProfile.adminPassword", common.ErrorCodeRequired, "WindowsProfile.AdminPassword must not be empty since  agent pool '%s' specifies windows", agentPoolProfile.Name)
 			}
 		}
 	}
-	if e := a.LinuxProfile.Validate(); e != nil {
-		return e
+	if a.LinuxProfile == nil {
+		errs.Add("properties.linuxProfile", common.ErrorCodeRequired, "LinuxProfile is required")
+	} else {
+		errs = append(errs, a.LinuxProfile.validate("properties.linuxProfile")...)
 	}
-	return nil
+	return errs.ErrorOrNil()
 }
 
-func validateName(name string, label string) error {
+func validateName(path string, name string) common.ValidationErrors {
+	var errs common.ValidationErrors
 	if name == "" {
-		return fmt.Errorf("%s must be a non-empty value", label)
+		errs.Add(path, common.ErrorCodeRequired, "must be a non-empty value")
 	}
-	return nil
+	return errs
 }
 
-func validatePoolName(poolName string) error {
-	// we will cap at length of 12 and all lowercase letters since this makes up the VMName
-	poolNameRegex := `^([a-z][a-z0-9]{0,11})$`
-	re, err := regexp.Compile(poolNameRegex)
-	if err != nil {
-		return err
-	}
-	submatches := re.FindStringSubmatch(poolName)
+var poolNameRegex = regexp.MustCompile(common.PoolNamePattern)
+
+func validatePoolName(path string, poolName string) common.ValidationErrors {
+	var errs common.ValidationErrors
+	submatches := poolNameRegex.FindStringSubmatch(poolName)
 	if len(submatches) != 2 {
-		return fmt.Errorf("pool name '%s' is invalid. A pool name must start with a lowercase letter, have max length of 12, and only have characters a-z0-9", poolName)
+		errs.Add(path, common.ErrorCodeInvalidFormat, "pool name '%s' is invalid. A pool name must start with a lowercase letter, have max length of 12, and only have characters a-z0-9", poolName)
 	}
-	return nil
+	return errs
 }
 
-func validateDNSName(dnsName string) error {
-	dnsNameRegex := `^([A-Za-z][A-Za-z0-9-]{1,43}[A-Za-z0-9])$`
-	re, err := regexp.Compile(dnsNameRegex)
-	if err != nil {
-		return err
+var dnsNameRegex = regexp.MustCompile(common.DNSPrefixPattern)
+
+func validateDNSName(path string, dnsName string) common.ValidationErrors {
+	if dnsName == "" {
+		return validateName(path, dnsName)
 	}
-	if !re.MatchString(dnsName) {
-		return fmt.Errorf("DNS name '%s' is invalid. The DNS name must contain between 3 and 45 characters.  The name can contain only letters, numbers, and hyphens.  The name must start with a letter and must end with a letter or a number. (length was %d)", dnsName, len(dnsName))
+	var errs common.ValidationErrors
+	if !dnsNameRegex.MatchString(dnsName) {
+		errs.Add(path, common.ErrorCodeInvalidFormat, "DNS name '%s' is invalid. The DNS name must contain between 3 and 45 characters.  The name can contain only letters, numbers, and hyphens.  The name must start with a letter and must end with a letter or a number. (length was %d)", dnsName, len(dnsName))
 	}
-	return nil
+	return errs
 }
 
-func validateUniqueProfileNames(profiles []*AgentPoolProfile) error {
+func validateUniqueProfileNames(path string, profiles []*AgentPoolProfile) common.ValidationErrors {
+	var errs common.ValidationErrors
 	profileNames := make(map[string]bool)
-	for _, profile := range profiles {
+	for i, profile := range profiles {
 		if _, ok := profileNames[profile.Name]; ok {
-			return fmt.Errorf("profile name '%s' already exists, profile names must be unique across pools", profile.Name)
+			errs.Add(common.IndexPath(path, i)+".name", common.ErrorCodeDuplicate, "profile name '%s' already exists, profile names must be unique across pools", profile.Name)
 		}
 		profileNames[profile.Name] = true
 	}
-	return nil
+	return errs
 }
 
-func validateUniquePorts(ports []int, name string) error {
+func validateUniquePorts(path string, ports []int, name string) common.ValidationErrors {
+	var errs common.ValidationErrors
 	portMap := make(map[int]bool)
-	for _, port := range ports {
+	for i, port := range ports {
 		if _, ok := portMap[port]; ok {
-			return fmt.Errorf("agent profile '%s' has duplicate port '%d', ports must be unique", name, port)
+			errs.Add(common.IndexPath(path, i), common.ErrorCodeDuplicate, "agent profile '%s' has duplicate port '%d', ports must be unique", name, port)
 		}
 		portMap[port] = true
 	}
-	return nil
+	return errs
 }
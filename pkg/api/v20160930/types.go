@@ -1,7 +1,6 @@
 package v20160930
 
 import (
-	"fmt"
 	neturl "net/url"
 	"strings"
 )
@@ -165,7 +164,7 @@ type VMDiagnostics struct {
 type OrchestratorType string
 
 // UnmarshalText decodes OrchestratorType text, do a case insensitive comparison with
-// the defined OrchestratorType constant and set to it if they equal
+// the defined OrchestratorType constant and set to it if they equal, otherwise keep the text
 func (o *OrchestratorType) UnmarshalText(text []byte) error {
 	s := string(text)
 	switch {
@@ -178,7 +177,8 @@ func (o *OrchestratorType) UnmarshalText(text []byte) error {
 	case strings.EqualFold(s, string(Kubernetes)):
 		*o = Kubernetes
 	default:
-		return fmt.Errorf("OrchestratorType has unknown orchestrator: %s", s)
+		// the unknown orchestrators are reported by Validate, with the path of the field
+		*o = OrchestratorType(s)
 	}
 
 	return nil
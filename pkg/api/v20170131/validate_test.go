@@ -0,0 +1,56 @@
+package v20170131
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
+
+func Test_Properties_ValidateAllErrors(t *testing.T) {
+	data := `{
+		"orchestratorProfile": {"orchestratorType": "Nomad"},
+		"masterProfile": {"count": 2, "dnsPrefix": "mydnsprefix"},
+		"agentPoolProfiles": [
+			{"name": "agentpool1", "count": 3, "vmSize": "Standard_D2_v2", "dnsPrefix": "mydnsprefix1"},
+			{"name": "agentpool1", "count": 3, "vmSize": "Standard_D2_v2", "dnsPrefix": "mydnsprefix2"},
+			{"name": "agentpool3", "count": 3, "dnsPrefix": "mydnsprefix3", "osType": "Windows"}
+		],
+		"linuxProfile": {"adminUsername": "azureuser", "ssh": {"publicKeys": [{"keyData": "ssh-rsa AAAA"}]}}
+	}`
+
+	p := &Properties{}
+	if err := json.Unmarshal([]byte(data), p); err != nil {
+		t.Fatalf("unknown orchestrators should be reported by Validate, not by json.Unmarshal: %v", err)
+	}
+
+	err := p.Validate()
+	errs, ok := err.(common.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected common.ValidationErrors, got %v", err)
+	}
+
+	expected := []common.ValidationError{
+		{Path: "properties.orchestratorProfile.orchestratorType", Code: common.ErrorCodeInvalidValue},
+		{Path: "properties.masterProfile.count", Code: common.ErrorCodeInvalidValue},
+		{Path: "properties.agentPoolProfiles[1].name", Code: common.ErrorCodeDuplicate},
+		{Path: "properties.agentPoolProfiles[2].vmSize", Code: common.ErrorCodeRequired},
+		{Path: "properties.agentPoolProfiles[2].osType", Code: common.ErrorCodeUnsupported},
+		{Path: "properties.windowsProfile", Code: common.ErrorCodeRequired},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, e := range expected {
+		if errs[i].Path != e.Path || errs[i].Code != e.Code {
+			t.Errorf("expected error %d to be %s %s, got %s %s", i, e.Path, e.Code, errs[i].Path, errs[i].Code)
+		}
+	}
+
+	p.OrchestratorProfile.OrchestratorType = DCOS
+	p.MasterProfile.Count = 1
+	p.AgentPoolProfiles = p.AgentPoolProfiles[:1]
+	if err := p.Validate(); err != nil {
+		t.Errorf("should not error on a valid api model: %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
+
+// ConvertContainerServiceVersion serializes an api model loaded from fromVersion in toVersion. The returned errors
+// are the fields of the api model that toVersion cannot represent, they are dropped or changed in the serialized api
+// model. An error is returned if the api model is not valid in toVersion.
+func ConvertContainerServiceVersion(containerService *ContainerService, fromVersion, toVersion string) ([]byte, common.ValidationErrors, error) {
+	source, err := SerializeContainerService(containerService, fromVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := SerializeContainerService(containerService, toVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// loading the converted api model back shows what it kept of the original one
+	converted, err := LoadContainerService(target, toVersion, false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("the api model is not valid in apiVersion %s: %s", toVersion, err.Error())
+	}
+	roundTrip, err := SerializeContainerService(converted, fromVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sourceValue, roundTripValue interface{}
+	if err := json.Unmarshal(source, &sourceValue); err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal(roundTrip, &roundTripValue); err != nil {
+		return nil, nil, err
+	}
+
+	var errs common.ValidationErrors
+	compareFields(sourceValue, roundTripValue, "", toVersion, &errs)
+	return target, errs, nil
+}
+
+// compareFields records an error for each value of source that is not kept by the conversion to version,
+// roundTrip being the result of converting source to version and back. The empty values are ignored,
+// as they are not set in the api model.
+func compareFields(source, roundTrip interface{}, path, version string, errs *common.ValidationErrors) {
+	if isEmptyValue(source) {
+		return
+	}
+
+	switch s := source.(type) {
+	case map[string]interface{}:
+		r, _ := roundTrip.(map[string]interface{})
+		keys := []string{}
+		for key := range s {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			compareFields(s[key], r[key], keyPath, version, errs)
+		}
+	case []interface{}:
+		r, _ := roundTrip.([]interface{})
+		for i := range s {
+			var v interface{}
+			if i < len(r) {
+				v = r[i]
+			}
+			compareFields(s[i], v, common.IndexPath(path, i), version, errs)
+		}
+	default:
+		if isEmptyValue(roundTrip) {
+			errs.Add(path, common.ErrorCodeUnsupported, "apiVersion %s does not support this field, the value %v is dropped", version, source)
+		} else if !reflect.DeepEqual(source, roundTrip) {
+			errs.Add(path, common.ErrorCodeUnsupported, "apiVersion %s does not support the value %v, it is changed to %v", version, source, roundTrip)
+		}
+	}
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
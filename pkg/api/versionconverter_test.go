@@ -0,0 +1,51 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
+	"github.com/Azure/acs-engine/pkg/api/v20170131"
+	"github.com/Azure/acs-engine/pkg/api/vlabs"
+)
+
+const vlabsKubernetes = `{
+  "apiVersion": "vlabs",
+  "properties": {
+    "orchestratorProfile": {"orchestratorType": "Kubernetes"},
+    "masterProfile": {"count": 1, "dnsPrefix": "mycluster", "vmSize": "Standard_D2_v2", "osDiskSizeGB": 200},
+    "agentPoolProfiles": [{"name": "agentpool1", "count": 3, "vmSize": "Standard_D2_v2", "availabilityProfile": "AvailabilitySet"}],
+    "linuxProfile": {"adminUsername": "azureuser", "ssh": {"publicKeys": [{"keyData": "ssh-rsa AAAA"}]}},
+    "servicePrincipalProfile": {"servicePrincipalClientID": "id", "servicePrincipalClientSecret": "secret"}
+  }
+}`
+
+func TestConvertContainerServiceVersion(t *testing.T) {
+	cs, version, err := DeserializeContainerService([]byte(vlabsKubernetes), true)
+	if err != nil {
+		t.Fatalf("unexpected error loading the api model: %s", err)
+	}
+
+	b, unsupported, err := ConvertContainerServiceVersion(cs, version, v20170131.APIVersion)
+	if err != nil {
+		t.Fatalf("unexpected error converting the api model: %s", err)
+	}
+	if len(unsupported) != 1 || unsupported[0].Path != "properties.masterProfile.osDiskSizeGB" || unsupported[0].Code != common.ErrorCodeUnsupported {
+		t.Fatalf("expected masterProfile.osDiskSizeGB to be unsupported, got %v", unsupported)
+	}
+
+	converted, version, err := DeserializeContainerService(b, true)
+	if err != nil {
+		t.Fatalf("unexpected error loading the converted api model: %s", err)
+	}
+	if version != v20170131.APIVersion {
+		t.Fatalf("expected apiVersion %s, got %s", v20170131.APIVersion, version)
+	}
+
+	// converting back to vlabs loses nothing
+	if _, unsupported, err = ConvertContainerServiceVersion(converted, version, vlabs.APIVersion); err != nil {
+		t.Fatalf("unexpected error converting the api model back: %s", err)
+	}
+	if len(unsupported) != 0 {
+		t.Fatalf("expected no unsupported fields, got %v", unsupported)
+	}
+}
@@ -1,7 +1,6 @@
 package vlabs
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -210,7 +209,7 @@ type OrchestratorType string
 type OrchestratorVersion string
 
 // UnmarshalText decodes OrchestratorType text, do a case insensitive comparison with
-// the defined OrchestratorType constant and set to it if they equal
+// the defined OrchestratorType constant and set to it if they equal, otherwise keep the text
 func (o *OrchestratorType) UnmarshalText(text []byte) error {
 	s := string(text)
 	switch {
@@ -223,7 +222,8 @@ func (o *OrchestratorType) UnmarshalText(text []byte) error {
 	case strings.EqualFold(s, string(SwarmMode)):
 		*o = SwarmMode
 	default:
-		return fmt.Errorf("OrchestratorType has unknown orchestrator: %s", s)
+		// the unknown orchestrators are reported by Validate, with the path of the field
+		*o = OrchestratorType(s)
 	}
 
 	return nil
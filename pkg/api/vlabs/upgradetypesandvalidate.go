@@ -1,27 +1,29 @@
 package vlabs
 
-import "fmt"
+import (
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
 
 // UpgradeContainerService API model
 type UpgradeContainerService struct {
 	OrchestratorProfile *OrchestratorProfile `json:"orchestratorProfile,omitempty"`
 }
 
-// Validate implements APIObject
+// Validate implements APIObject. The error is a common.ValidationErrors listing every problem found.
 func (ucs *UpgradeContainerService) Validate() error {
-	switch ucs.OrchestratorProfile.OrchestratorType {
-	case DCOS:
-	case Swarm:
-	case SwarmMode:
-		return fmt.Errorf("Upgrade is not supported for orchestrator: %s \n", ucs.OrchestratorProfile.OrchestratorType)
-	case Kubernetes:
-		switch ucs.OrchestratorProfile.OrchestratorVersion {
-		case Kubernetes162:
-		case Kubernetes160:
-		default:
-			return fmt.Errorf("Invalid orchestrator version: %s \n", ucs.OrchestratorProfile.OrchestratorVersion)
-		}
+	var errs common.ValidationErrors
+
+	if ucs.OrchestratorProfile == nil {
+		errs.Add("orchestratorProfile", common.ErrorCodeRequired, "OrchestratorProfile is required")
+		return errs
+	}
+
+	orchestratorType := string(ucs.OrchestratorProfile.OrchestratorType)
+	if !common.IsUpgradeSupportedForOrchestrator(orchestratorType) {
+		errs.Add("orchestratorProfile.orchestratorType", common.ErrorCodeUnsupported, "Upgrade is not supported for orchestrator: %s", orchestratorType)
+	} else if !common.IsUpgradeTargetVersion(orchestratorType, string(ucs.OrchestratorProfile.OrchestratorVersion)) {
+		errs.Add("orchestratorProfile.orchestratorVersion", common.ErrorCodeInvalidValue, "Invalid orchestrator version: %s", ucs.OrchestratorProfile.OrchestratorVersion)
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -1,15 +1,21 @@
 package vlabs
 
 import (
-	"errors"
-	"fmt"
 	"net"
 	"net/url"
 	"regexp"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 // Validate implements APIObject
 func (o *OrchestratorProfile) Validate() error {
+	return o.validate("orchestratorProfile").ErrorOrNil()
+}
+
+func (o *OrchestratorProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
 	switch o.OrchestratorType {
 	case DCOS:
 		switch o.OrchestratorVersion {
@@ -20,7 +26,7 @@ func (o *OrchestratorProfile) Validate() error {
 		case DCOS190:
 		case "":
 		default:
-			return fmt.Errorf("OrchestratorProfile has unknown orchestrator version: %s \n", o.OrchestratorVersion)
+			errs.Add(path+".orchestratorVersion", common.ErrorCodeInvalidValue, "unknown orchestrator version: %s", o.OrchestratorVersion)
 		}
 
 	case Swarm:
@@ -34,280 +40,280 @@ func (o *OrchestratorProfile) Validate() error {
 		case Kubernetes153:
 		case "":
 		default:
-			return fmt.Errorf("OrchestratorProfile has unknown orchestrator version: %s \n", o.OrchestratorVersion)
+			errs.Add(path+".orchestratorVersion", common.ErrorCodeInvalidValue, "unknown orchestrator version: %s", o.OrchestratorVersion)
 		}
 
 		if o.KubernetesConfig != nil {
-			err := o.KubernetesConfig.Validate()
-			if err != nil {
-				return err
-			}
+			errs = append(errs, o.KubernetesConfig.validate(path+".kubernetesConfig")...)
 		}
 
 	default:
-		return fmt.Errorf("OrchestratorProfile has unknown orchestrator: %s", o.OrchestratorType)
+		errs.Add(path+".orchestratorType", common.ErrorCodeInvalidValue, "unknown orchestrator: %s", o.OrchestratorType)
 	}
 
 	if o.OrchestratorType != Kubernetes && o.KubernetesConfig != nil && (*o.KubernetesConfig != KubernetesConfig{}) {
-		return fmt.Errorf("KubernetesConfig can be specified only when OrchestratorType is Kubernetes")
+		errs.Add(path+".kubernetesConfig", common.ErrorCodeMustBeEmpty, "KubernetesConfig can be specified only when OrchestratorType is Kubernetes")
 	}
 
-	return nil
+	return errs
 }
 
 // Validate implements APIObject
 func (m *MasterProfile) Validate() error {
+	return m.validate("masterProfile").ErrorOrNil()
+}
+
+func (m *MasterProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
 	if m.Count != 1 && m.Count != 3 && m.Count != 5 {
-		return fmt.Errorf("MasterProfile count needs to be 1, 3, or 5")
-	}
-	if e := validateName(m.DNSPrefix, "MasterProfile.DNSPrefix"); e != nil {
-		return e
-	}
-	if e := validateDNSName(m.DNSPrefix); e != nil {
-		return e
-	}
-	if e := validateName(m.VMSize, "MasterProfile.VMSize"); e != nil {
-		return e
+		errs.Add(path+".count", common.ErrorCodeInvalidValue, "MasterProfile count needs to be 1, 3, or 5")
 	}
+	errs = append(errs, validateDNSName(path+".dnsPrefix", m.DNSPrefix)...)
+	errs = append(errs, validateName(path+".vmSize", m.VMSize)...)
 	if m.OSDiskSizeGB != 0 && (m.OSDiskSizeGB < MinDiskSizeGB || m.OSDiskSizeGB > MaxDiskSizeGB) {
-		return fmt.Errorf("Invalid master os disk size of %d specified.  The range of valid values are [%d, %d]", m.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
+		errs.Add(path+".osDiskSizeGB", common.ErrorCodeOutOfRange, "Invalid master os disk size of %d specified.  The range of valid values are [%d, %d]", m.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
 	}
 	if m.IPAddressCount != 0 && (m.IPAddressCount < MinIPAddressCount || m.IPAddressCount > MaxIPAddressCount) {
-		return fmt.Errorf("MasterProfile.IPAddressCount needs to be in the range [%d,%d]", MinIPAddressCount, MaxIPAddressCount)
+		errs.Add(path+".ipAddressCount", common.ErrorCodeOutOfRange, "MasterProfile.IPAddressCount needs to be in the range [%d,%d]", MinIPAddressCount, MaxIPAddressCount)
 	}
-	return nil
+	return errs
 }
 
 // Validate implements APIObject
 func (a *AgentPoolProfile) Validate(orchestratorType OrchestratorType) error {
-	if e := validateName(a.Name, "AgentPoolProfile.Name"); e != nil {
-		return e
-	}
-	if e := validatePoolName(a.Name); e != nil {
-		return e
+	return a.validate("agentPoolProfile", orchestratorType).ErrorOrNil()
+}
+
+func (a *AgentPoolProfile) validate(path string, orchestratorType OrchestratorType) common.ValidationErrors {
+	var errs common.ValidationErrors
+
+	if a.Name == "" {
+		errs = append(errs, validateName(path+".name", a.Name)...)
+	} else {
+		errs = append(errs, validatePoolName(path+".name", a.Name)...)
 	}
 	if a.Count < MinAgentCount || a.Count > MaxAgentCount {
-		return fmt.Errorf("AgentPoolProfile count needs to be in the range [%d,%d]", MinAgentCount, MaxAgentCount)
-	}
-	if e := validateName(a.VMSize, "AgentPoolProfile.VMSize"); e != nil {
-		return e
+		errs.Add(path+".count", common.ErrorCodeOutOfRange, "AgentPoolProfile count needs to be in the range [%d,%d]", MinAgentCount, MaxAgentCount)
 	}
+	errs = append(errs, validateName(path+".vmSize", a.VMSize)...)
 	if a.OSDiskSizeGB != 0 && (a.OSDiskSizeGB < MinDiskSizeGB || a.OSDiskSizeGB > MaxDiskSizeGB) {
-		return fmt.Errorf("Invalid os disk size of %d specified.  The range of valid values are [%d, %d]", a.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
+		errs.Add(path+".osDiskSizeGB", common.ErrorCodeOutOfRange, "Invalid os disk size of %d specified.  The range of valid values are [%d, %d]", a.OSDiskSizeGB, MinDiskSizeGB, MaxDiskSizeGB)
 	}
-	if a.DNSPrefix != "" {
-		if e := validateDNSName(a.DNSPrefix); e != nil {
-			return e
-		}
+
+	// for Kubernetes, we don't support AgentPoolProfile.DNSPrefix, Kubernetes marks its own clusters public
+	if orchestratorType == Kubernetes {
+		errs = append(errs, validateNameEmpty(path+".dnsPrefix", a.DNSPrefix)...)
+	} else if a.DNSPrefix != "" {
+		errs = append(errs, validateDNSName(path+".dnsPrefix", a.DNSPrefix)...)
 		if len(a.Ports) > 0 {
-			if e := validateUniquePorts(a.Ports, a.Name); e != nil {
-				return e
-			}
-			for _, port := range a.Ports {
+			errs = append(errs, validateUniquePorts(path+".ports", a.Ports, a.Name)...)
+			for i, port := range a.Ports {
 				if port < MinPort || port > MaxPort {
-					return fmt.Errorf("AgentPoolProfile Ports must be in the range[%d, %d]", MinPort, MaxPort)
+					errs.Add(common.IndexPath(path+".ports", i), common.ErrorCodeOutOfRange, "AgentPoolProfile Ports must be in the range[%d, %d]", MinPort, MaxPort)
 				}
 			}
 		} else {
 			a.Ports = []int{80, 443, 8080}
 		}
-	} else {
-		if len(a.Ports) > 0 {
-			return fmt.Errorf("AgentPoolProfile.Ports must be empty when AgentPoolProfile.DNSPrefix is empty")
-		}
+	} else if len(a.Ports) > 0 {
+		errs.Add(path+".ports", common.ErrorCodeMustBeEmpty, "AgentPoolProfile.Ports must be empty when AgentPoolProfile.DNSPrefix is empty")
 	}
 
-	// for Kubernetes, we don't support AgentPoolProfile.DNSPrefix
-	if orchestratorType == Kubernetes {
-		if e := validateNameEmpty(a.DNSPrefix, "AgentPoolProfile.DNSPrefix"); e != nil {
-			return e
-		}
+	switch a.AvailabilityProfile {
+	case AvailabilitySet:
+	case VirtualMachineScaleSets:
+	case "":
+	default:
+		errs.Add(path+".availabilityProfile", common.ErrorCodeInvalidValue, "unknown availability profile type '%s' for agent pool '%s'.  Specify either %s, or %s", a.AvailabilityProfile, a.Name, AvailabilitySet, VirtualMachineScaleSets)
 	}
+	errs = append(errs, validateStorageProfile(path+".storageProfile", a.StorageProfile)...)
 
 	if len(a.DiskSizesGB) > 0 {
 		if len(a.StorageProfile) == 0 {
-			return fmt.Errorf("property 'StorageProfile' must be set to either '%s' or '%s' when attaching disks", StorageAccount, ManagedDisks)
+			errs.Add(path+".storageProfile", common.ErrorCodeRequired, "property 'StorageProfile' must be set to either '%s' or '%s' when attaching disks", StorageAccount, ManagedDisks)
 		}
 		if len(a.AvailabilityProfile) == 0 {
-			return fmt.Errorf("property 'AvailabilityProfile' must be set to either '%s' or '%s' when attaching disks", VirtualMachineScaleSets, AvailabilitySet)
+			errs.Add(path+".availabilityProfile", common.ErrorCodeRequired, "property 'AvailabilityProfile' must be set to either '%s' or '%s' when attaching disks", VirtualMachineScaleSets, AvailabilitySet)
 		}
 		if a.StorageProfile == StorageAccount && (a.AvailabilityProfile == VirtualMachineScaleSets) {
-			return fmt.Errorf("VirtualMachineScaleSets does not support storage account attached disks.  Instead specify 'StorageAccount': '%s' or specify AvailabilityProfile '%s'", ManagedDisks, AvailabilitySet)
+			errs.Add(path+".storageProfile", common.ErrorCodeUnsupported, "VirtualMachineScaleSets does not support storage account attached disks.  Instead specify 'StorageAccount': '%s' or specify AvailabilityProfile '%s'", ManagedDisks, AvailabilitySet)
 		}
 	}
-	for _, s := range a.DiskSizesGB {
+	for i, s := range a.DiskSizesGB {
 		if s < MinDiskSizeGB || s > MaxDiskSizeGB {
-			return fmt.Errorf("Invalid disk size %d specified for cluster cluster named '%s'.  The range of valid values are [%d, %d]", s, a.Name, MinDiskSizeGB, MaxDiskSizeGB)
+			errs.Add(common.IndexPath(path+".diskSizesGB", i), common.ErrorCodeOutOfRange, "Invalid disk size %d specified for cluster cluster named '%s'.  The range of valid values are [%d, %d]", s, a.Name, MinDiskSizeGB, MaxDiskSizeGB)
 		}
 	}
 	if len(a.DiskSizesGB) > MaxDisks {
-		return fmt.Errorf("A maximum of %d disks may be specified.  %d disks were specified for cluster named '%s'", MaxDisks, len(a.DiskSizesGB), a.Name)
-	}
-	if len(a.Ports) == 0 && len(a.DNSPrefix) > 0 {
-		return fmt.Errorf("AgentPoolProfile.Ports must be non empty when AgentPoolProfile.DNSPrefix is specified")
+		errs.Add(path+".diskSizesGB", common.ErrorCodeOutOfRange, "A maximum of %d disks may be specified.  %d disks were specified for cluster named '%s'", MaxDisks, len(a.DiskSizesGB), a.Name)
 	}
 	if a.IPAddressCount != 0 && (a.IPAddressCount < MinIPAddressCount || a.IPAddressCount > MaxIPAddressCount) {
-		return fmt.Errorf("AgentPoolProfile.IPAddressCount needs to be in the range [%d,%d]", MinIPAddressCount, MaxIPAddressCount)
-	}
-	if e := validateStorageProfile(a.StorageProfile); e != nil {
-		return e
+		errs.Add(path+".ipAddressCount", common.ErrorCodeOutOfRange, "AgentPoolProfile.IPAddressCount needs to be in the range [%d,%d]", MinIPAddressCount, MaxIPAddressCount)
 	}
-	return nil
+	return errs
 }
 
-func validateKeyVaultSecrets(secrets []KeyVaultSecrets, requireCertificateStore bool) error {
-	for _, s := range secrets {
+func validateKeyVaultSecrets(path string, secrets []KeyVaultSecrets, requireCertificateStore bool) common.ValidationErrors {
+	var errs common.ValidationErrors
+
+	for i, s := range secrets {
+		secretPath := common.IndexPath(path, i)
 		if len(s.VaultCertificates) == 0 {
-			return fmt.Errorf("Invalid KeyVaultSecrets must have no empty VaultCertificates")
+			errs.Add(secretPath+".vaultCertificates", common.ErrorCodeRequired, "Invalid KeyVaultSecrets must have no empty VaultCertificates")
 		}
-		if s.SourceVault.ID == "" {
-			return fmt.Errorf("KeyVaultSecrets must have a SourceVault.ID")
+		if s.SourceVault == nil || s.SourceVault.ID == "" {
+			errs.Add(secretPath+".sourceVault.id", common.ErrorCodeRequired, "KeyVaultSecrets must have a SourceVault.ID")
 		}
-		for _, c := range s.VaultCertificates {
+		for j, c := range s.VaultCertificates {
+			certificatePath := common.IndexPath(secretPath+".vaultCertificates", j)
 			if _, e := url.Parse(c.CertificateURL); e != nil {
-				return fmt.Errorf("Certificate url was invalid. recieved error %s", e)
+				errs.Add(certificatePath+".certificateUrl", common.ErrorCodeInvalidFormat, "Certificate url was invalid. recieved error %s", e)
 			}
-			if e := validateName(c.CertificateStore, "KeyVaultCertificate.CertificateStore"); requireCertificateStore && e != nil {
-				return fmt.Errorf("%s for certificates in a WindowsProfile", e)
+			if requireCertificateStore && c.CertificateStore == "" {
+				errs.Add(certificatePath+".certificateStore", common.ErrorCodeRequired, "must be a non-empty value for certificates in a WindowsProfile")
 			}
 		}
 	}
-	return nil
+	return errs
 }
 
 // Validate implements APIObject
 func (l *LinuxProfile) Validate() error {
-	if e := validateName(l.AdminUsername, "LinuxProfile.AdminUsername"); e != nil {
-		return e
-	}
+	return l.validate("linuxProfile").ErrorOrNil()
+}
+
+func (l *LinuxProfile) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
+	errs = append(errs, validateName(path+".adminUsername", l.AdminUsername)...)
 	if len(l.SSH.PublicKeys) != 1 {
-		return errors.New("LinuxProfile.PublicKeys requires only 1 SSH Key")
-	}
-	if e := validateName(l.SSH.PublicKeys[0].KeyData, "LinuxProfile.PublicKeys.KeyData"); e != nil {
-		return e
-	}
-	if e := validateKeyVaultSecrets(l.Secrets, false); e != nil {
-		return e
+		errs.Add(path+".ssh.publicKeys", common.ErrorCodeInvalidValue, "LinuxProfile.PublicKeys requires only 1 SSH Key")
+	} else {
+		errs = append(errs, validateName(path+".ssh.publicKeys[0].keyData", l.SSH.PublicKeys[0].KeyData)...)
 	}
-	return nil
+	errs = append(errs, validateKeyVaultSecrets(path+".secrets", l.Secrets, false)...)
+	return errs
 }
 
-// Validate implements APIObject
+// Validate implements APIObject. The error is a common.ValidationErrors listing every problem found.
 func (a *Properties) Validate() error {
-	if e := a.OrchestratorProfile.Validate(); e != nil {
-		return e
-	}
-	if e := a.validateNetworkPolicy(); e != nil {
-		return e
-	}
-	if e := a.MasterProfile.Validate(); e != nil {
-		return e
-	}
-	if e := validateUniqueProfileNames(a.AgentPoolProfiles); e != nil {
-		return e
-	}
-	if a.OrchestratorProfile.OrchestratorType == Kubernetes && len(a.ServicePrincipalProfile.ClientID) == 0 {
-		return fmt.Errorf("the service principal client ID must be specified with Orchestrator %s", a.OrchestratorProfile.OrchestratorType)
+	var errs common.ValidationErrors
+
+	if a == nil {
+		errs.Add("properties", common.ErrorCodeRequired, "Properties is required")
+		return errs
 	}
 
-	if a.OrchestratorProfile.OrchestratorType == Kubernetes && len(a.ServicePrincipalProfile.Secret) == 0 {
-		return fmt.Errorf("the service principal client secrect must be specified with Orchestrator %s", a.OrchestratorProfile.OrchestratorType)
+	var orchestratorType OrchestratorType
+	if a.OrchestratorProfile == nil {
+		errs.Add("properties.orchestratorProfile", common.ErrorCodeRequired, "OrchestratorProfile is required")
+	} else {
+		orchestratorType = a.OrchestratorProfile.OrchestratorType
+		errs = append(errs, a.OrchestratorProfile.validate("properties.orchestratorProfile")...)
+		errs = append(errs, a.validateNetworkPolicy()...)
 	}
+	if a.MasterProfile == nil {
+		errs.Add("properties.masterProfile", common.ErrorCodeRequired, "MasterProfile is required")
+	} else {
+		errs = append(errs, a.MasterProfile.validate("properties.masterProfile")...)
+	}
+	errs = append(errs, validateUniqueProfileNames("properties.agentPoolProfiles", a.AgentPoolProfiles)...)
 
-	for _, agentPoolProfile := range a.AgentPoolProfiles {
-		if e := agentPoolProfile.Validate(a.OrchestratorProfile.OrchestratorType); e != nil {
-			return e
+	if orchestratorType == Kubernetes {
+		if a.ServicePrincipalProfile == nil || len(a.ServicePrincipalProfile.ClientID) == 0 {
+			errs.Add("properties.servicePrincipalProfile.servicePrincipalClientID", common.ErrorCodeRequired, "the service principal client ID must be specified with Orchestrator %s", orchestratorType)
 		}
-		switch agentPoolProfile.AvailabilityProfile {
-		case AvailabilitySet:
-		case VirtualMachineScaleSets:
-		case "":
-		default:
-			{
-				return fmt.Errorf("unknown availability profile type '%s' for agent pool '%s'.  Specify either %s, or %s", agentPoolProfile.AvailabilityProfile, agentPoolProfile.Name, AvailabilitySet, VirtualMachineScaleSets)
-			}
+		if a.ServicePrincipalProfile == nil || len(a.ServicePrincipalProfile.Secret) == 0 {
+			errs.Add("properties.servicePrincipalProfile.servicePrincipalClientSecret", common.ErrorCodeRequired, "the service principal client secrect must be specified with Orchestrator %s", orchestratorType)
 		}
-		switch agentPoolProfile.StorageProfile {
-		case StorageAccount:
-		case ManagedDisks:
-		case "":
-		default:
-			{
-				return fmt.Errorf("unknown storage type '%s' for agent pool '%s'.  Specify either %s, or %s", agentPoolProfile.StorageProfile, agentPoolProfile.Name, StorageAccount, ManagedDisks)
-			}
-		}
-		/* this switch statement is left to protect newly added orchestrators until they support Managed Disks*/
+	}
+
+	windowsProfileChecked := false
+	for i, agentPoolProfile := range a.AgentPoolProfiles {
+		path := common.IndexPath("properties.agentPoolProfiles", i)
+		errs = append(errs, agentPoolProfile.validate(path, orchestratorType)...)
 
+		/* this switch statement is left to protect newly added orchestrators until they support Managed Disks*/
 		if agentPoolProfile.StorageProfile == ManagedDisks {
-			switch a.OrchestratorProfile.OrchestratorType {
+			switch orchestratorType {
 			case DCOS:
 			case Swarm:
 			case Kubernetes:
 			case SwarmMode:
 			default:
-				return fmt.Errorf("HA volumes are currently unsupported for Orchestrator %s", a.OrchestratorProfile.OrchestratorType)
+				errs.Add(path+".storageProfile", common.ErrorCodeUnsupported, "HA volumes are currently unsupported for Orchestrator %s", orchestratorType)
 			}
 		}
 
 		if len(agentPoolProfile.CustomNodeLabels) > 0 {
-			switch a.OrchestratorProfile.OrchestratorType {
+			switch orchestratorType {
 			case DCOS:
 			default:
-				return fmt.Errorf("Agent Type attributes are only supported for DCOS.")
+				errs.Add(path+".customNodeLabels", common.ErrorCodeUnsupported, "Agent Type attributes are only supported for DCOS.")
 			}
 		}
-		if a.OrchestratorProfile.OrchestratorType == Kubernetes && (agentPoolProfile.AvailabilityProfile == VirtualMachineScaleSets || len(agentPoolProfile.AvailabilityProfile) == 0) {
-			return fmt.Errorf("VirtualMachineScaleSets are not supported with Kubernetes since Kubernetes requires the ability to attach/detach disks.  To fix specify \"AvailabilityProfile\":\"%s\"", AvailabilitySet)
-		}
-		if a.OrchestratorProfile.OrchestratorType == Kubernetes && len(agentPoolProfile.DNSPrefix) > 0 {
-			return errors.New("DNSPrefix not support for agent pools in Kubernetes - Kubernetes marks its own clusters public")
+		if orchestratorType == Kubernetes && (agentPoolProfile.AvailabilityProfile == VirtualMachineScaleSets || len(agentPoolProfile.AvailabilityProfile) == 0) {
+			errs.Add(path+".availabilityProfile", common.ErrorCodeUnsupported, "VirtualMachineScaleSets are not supported with Kubernetes since Kubernetes requires the ability to attach/detach disks.  To fix specify \"AvailabilityProfile\":\"%s\"", AvailabilitySet)
 		}
 		if agentPoolProfile.OSType == Windows {
-			switch a.OrchestratorProfile.OrchestratorType {
+			switch orchestratorType {
 			case Swarm:
 			case SwarmMode:
 			case Kubernetes:
 			default:
-				return fmt.Errorf("Orchestrator %s does not support Windows", a.OrchestratorProfile.OrchestratorType)
+				errs.Add(path+".osType", common.ErrorCodeUnsupported, "Orchestrator %s does not support Windows", orchestratorType)
 			}
 
+			// the WindowsProfile is shared by the Windows pools, its problems are only reported once
+			if windowsProfileChecked {
+				continue
+			}
+			windowsProfileChecked = true
 			if a.WindowsProfile == nil {
-				return fmt.Errorf("WindowsProfile must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
+				errs.Add("properties.windowsProfile", common.ErrorCodeRequired, "WindowsProfile must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
+				continue
 			}
-
 			if len(a.WindowsProfile.AdminUsername) == 0 {
-				return fmt.Errorf("WindowsProfile.AdminUsername must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
+				errs.Add("properties.windowsProfile.adminUsername", common.ErrorCodeRequired, "WindowsProfile.AdminUsername must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
 			}
 			if len(a.WindowsProfile.AdminPassword) == 0 {
-				return fmt.Errorf("WindowsProfile.AdminPassword must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
-			}
-			if e := validateKeyVaultSecrets(a.WindowsProfile.Secrets, true); e != nil {
-				return e
+				errs.Add("properties.windowsProfile.adminPassword", common.ErrorCodeRequired, "WindowsProfile.AdminPassword must not be empty since agent pool '%s' specifies windows", agentPoolProfile.Name)
 			}
+			errs = append(errs, validateKeyVaultSecrets("properties.windowsProfile.secrets", a.WindowsProfile.Secrets, true)...)
 		}
 	}
-	if e := a.LinuxProfile.Validate(); e != nil {
-		return e
+	if a.LinuxProfile == nil {
+		errs.Add("properties.linuxProfile", common.ErrorCodeRequired, "LinuxProfile is required")
+	} else {
+		errs = append(errs, a.LinuxProfile.validate("properties.linuxProfile")...)
 	}
-	if e := validateVNET(a); e != nil {
-		return e
+	if a.MasterProfile != nil {
+		errs = append(errs, validateVNET(a)...)
 	}
-	return nil
+	return errs.ErrorOrNil()
 }
 
 // Validate validates the KubernetesConfig.
 func (a *KubernetesConfig) Validate() error {
+	return a.validate("kubernetesConfig").ErrorOrNil()
+}
+
+func (a *KubernetesConfig) validate(path string) common.ValidationErrors {
+	var errs common.ValidationErrors
+
 	if a.ClusterSubnet != "" {
 		_, _, err := net.ParseCIDR(a.ClusterSubnet)
 		if err != nil {
-			return fmt.Errorf("OrchestratorProfile.KubernetesConfig.ClusterSubnet '%s' is an invalid subnet", a.ClusterSubnet)
+			errs.Add(path+".clusterSubnet", common.ErrorCodeInvalidFormat, "OrchestratorProfile.KubernetesConfig.ClusterSubnet '%s' is an invalid subnet", a.ClusterSubnet)
 		}
 	}
 
-	return nil
+	return errs
 }
 
-func (a *Properties) validateNetworkPolicy() error {
+func (a *Properties) validateNetworkPolicy() common.ValidationErrors {
+	var errs common.ValidationErrors
 	var networkPolicy string
 
 	switch a.OrchestratorProfile.OrchestratorType {
@@ -328,123 +334,131 @@ func (a *Properties) validateNetworkPolicy() error {
 		}
 	}
 	if !valid {
-		return fmt.Errorf("unknown networkPolicy '%s' specified", networkPolicy)
+		errs.Add("properties.orchestratorProfile.kubernetesConfig.networkPolicy", common.ErrorCodeInvalidValue, "unknown networkPolicy '%s' specified", networkPolicy)
 	}
 
 	// Temporary safety check, to be removed when Windows support is added.
 	if (networkPolicy == "calico" || networkPolicy == "azure") && a.HasWindows() {
-		return fmt.Errorf("networkPolicy '%s' is not supporting windows agents", networkPolicy)
+		errs.Add("properties.orchestratorProfile.kubernetesConfig.networkPolicy", common.ErrorCodeUnsupported, "networkPolicy '%s' is not supporting windows agents", networkPolicy)
 	}
 
-	return nil
+	return errs
 }
 
-func validateNameEmpty(name string, label string) error {
+func validateNameEmpty(path string, name string) common.ValidationErrors {
+	var errs common.ValidationErrors
 	if name != "" {
-		return fmt.Errorf("%s must be an empty value", label)
+		errs.Add(path, common.ErrorCodeMustBeEmpty, "must be an empty value")
 	}
-	return nil
+	return errs
 }
 
-func validateName(name string, label string) error {
+func validateName(path string, name string) common.ValidationErrors {
+	var errs common.ValidationErrors
 	if name == "" {
-		return fmt.Errorf("%s must be a non-empty value", label)
+		errs.Add(path, common.ErrorCodeRequired, "must be a non-empty value")
 	}
-	return nil
+	return errs
 }
 
-func validatePoolName(poolName string) error {
-	// we will cap at length of 12 and all lowercase letters since this makes up the VMName
-	poolNameRegex := `^([a-z][a-z0-9]{0,11})$`
-	re, err := regexp.Compile(poolNameRegex)
-	if err != nil {
-		return err
-	}
-	submatches := re.FindStringSubmatch(poolName)
+var poolNameRegex = regexp.MustCompile(common.PoolNamePattern)
+
+func validatePoolName(path string, poolName string) common.ValidationErrors {
+	var errs common.ValidationErrors
+	submatches := poolNameRegex.FindStringSubmatch(poolName)
 	if len(submatches) != 2 {
-		return fmt.Errorf("pool name '%s' is invalid. A pool name must start with a lowercase letter, have max length of 12, and only have characters a-z0-9", poolName)
+		errs.Add(path, common.ErrorCodeInvalidFormat, "pool name '%s' is invalid. A pool name must start with a lowercase letter, have max length of 12, and only have characters a-z0-9", poolName)
 	}
-	return nil
+	return errs
 }
 
-func validateDNSName(dnsName string) error {
-	dnsNameRegex := `^([A-Za-z][A-Za-z0-9-]{1,43}[A-Za-z0-9])$`
-	re, err := regexp.Compile(dnsNameRegex)
-	if err != nil {
-		return err
+var dnsNameRegex = regexp.MustCompile(common.DNSPrefixPattern)
+
+func validateDNSName(path string, dnsName string) common.ValidationErrors {
+	if dnsName == "" {
+		return validateName(path, dnsName)
 	}
-	if !re.MatchString(dnsName) {
-		return fmt.Errorf("DNS name '%s' is invalid. The DNS name must contain between 3 and 45 characters.  The name can contain only letters, numbers, and hyphens.  The name must start with a letter and must end with a letter or a number (length was %d)", dnsName, len(dnsName))
+	var errs common.ValidationErrors
+	if !dnsNameRegex.MatchString(dnsName) {
+		errs.Add(path, common.ErrorCodeInvalidFormat, "DNS name '%s' is invalid. The DNS name must contain between 3 and 45 characters.  The name can contain only letters, numbers, and hyphens.  The name must start with a letter and must end with a letter or a number (length was %d)", dnsName, len(dnsName))
 	}
-	return nil
+	return errs
 }
 
-func validateUniqueProfileNames(profiles []*AgentPoolProfile) error {
+func validateUniqueProfileNames(path string, profiles []*AgentPoolProfile) common.ValidationErrors {
+	var errs common.ValidationErrors
 	profileNames := make(map[string]bool)
-	for _, profile := range profiles {
+	for i, profile := range profiles {
 		if _, ok := profileNames[profile.Name]; ok {
-			return fmt.Errorf("profile name '%s' already exists, profile names must be unique across pools", profile.Name)
+			errs.Add(common.IndexPath(path, i)+".name", common.ErrorCodeDuplicate, "profile name '%s' already exists, profile names must be unique across pools", profile.Name)
 		}
 		profileNames[profile.Name] = true
 	}
-	return nil
+	return errs
 }
 
-func validateStorageProfile(storageProfile string) error {
+func validateStorageProfile(path string, storageProfile string) common.ValidationErrors {
+	var errs common.ValidationErrors
 	switch storageProfile {
 	case StorageAccount:
 	case ManagedDisks:
 	case "":
 	default:
-		{
-			return fmt.Errorf("Unknown storage type '%s' for agent pool. Specify either %s or %s", storageProfile, StorageAccount, ManagedDisks)
-		}
+		errs.Add(path, common.ErrorCodeInvalidValue, "Unknown storage type '%s' for agent pool. Specify either %s or %s", storageProfile, StorageAccount, ManagedDisks)
 	}
-	return nil
+	return errs
 }
 
-func validateUniquePorts(ports []int, name string) error {
+func validateUniquePorts(path string, ports []int, name string) common.ValidationErrors {
+	var errs common.ValidationErrors
 	portMap := make(map[int]bool)
-	for _, port := range ports {
+	for i, port := range ports {
 		if _, ok := portMap[port]; ok {
-			return fmt.Errorf("agent profile '%s' has duplicate port '%d', ports must be unique", name, port)
+			errs.Add(common.IndexPath(path, i), common.ErrorCodeDuplicate, "agent profile '%s' has duplicate port '%d', ports must be unique", name, port)
 		}
 		portMap[port] = true
 	}
-	return nil
+	return errs
 }
 
-func validateVNET(a *Properties) error {
+func validateVNET(a *Properties) common.ValidationErrors {
+	var errs common.ValidationErrors
+
 	isCustomVNET := a.MasterProfile.IsCustomVNET()
-	for _, agentPool := range a.AgentPoolProfiles {
+	for i, agentPool := range a.AgentPoolProfiles {
 		if agentPool.IsCustomVNET() != isCustomVNET {
-			return fmt.Errorf("Multiple VNET Subnet configurations specified.  The master profile and each agent pool profile must all specify a custom VNET Subnet, or none at all.")
+			errs.Add(common.IndexPath("properties.agentPoolProfiles", i)+".vnetSubnetID", common.ErrorCodeInconsistent, "Multiple VNET Subnet configurations specified.  The master profile and each agent pool profile must all specify a custom VNET Subnet, or none at all.")
 		}
 	}
-	if isCustomVNET {
-		subscription, resourcegroup, vnetname, _, e := GetVNETSubnetIDComponents(a.MasterProfile.VnetSubnetID)
-		if e != nil {
-			return e
-		}
+	if !isCustomVNET || len(errs) > 0 {
+		return errs
+	}
 
-		for _, agentPool := range a.AgentPoolProfiles {
-			agentSubID, agentRG, agentVNET, _, err := GetVNETSubnetIDComponents(agentPool.VnetSubnetID)
-			if err != nil {
-				return err
-			}
-			if agentSubID != subscription ||
-				agentRG != resourcegroup ||
-				agentVNET != vnetname {
-				return errors.New("Multipe VNETS specified.  The master profile and each agent pool must reference the same VNET (but it is ok to reference different subnets on that VNET)")
-			}
-		}
+	subscription, resourcegroup, vnetname, _, e := GetVNETSubnetIDComponents(a.MasterProfile.VnetSubnetID)
+	if e != nil {
+		errs.Add("properties.masterProfile.vnetSubnetID", common.ErrorCodeInvalidFormat, "%s", e)
+		return errs
+	}
 
-		masterFirstIP := net.ParseIP(a.MasterProfile.FirstConsecutiveStaticIP)
-		if masterFirstIP == nil {
-			return fmt.Errorf("MasterProfile.FirstConsecutiveStaticIP (with VNET Subnet specification) '%s' is an invalid IP address", a.MasterProfile.FirstConsecutiveStaticIP)
+	for i, agentPool := range a.AgentPoolProfiles {
+		path := common.IndexPath("properties.agentPoolProfiles", i) + ".vnetSubnetID"
+		agentSubID, agentRG, agentVNET, _, err := GetVNETSubnetIDComponents(agentPool.VnetSubnetID)
+		if err != nil {
+			errs.Add(path, common.ErrorCodeInvalidFormat, "%s", err)
+			continue
+		}
+		if agentSubID != subscription ||
+			agentRG != resourcegroup ||
+			agentVNET != vnetname {
+			errs.Add(path, common.ErrorCodeInconsistent, "Multipe VNETS specified.  The master profile and each agent pool must reference the same VNET (but it is ok to reference different subnets on that VNET)")
 		}
 	}
-	return nil
+
+	masterFirstIP := net.ParseIP(a.MasterProfile.FirstConsecutiveStaticIP)
+	if masterFirstIP == nil {
+		errs.Add("properties.masterProfile.firstConsecutiveStaticIP", common.ErrorCodeInvalidFormat, "MasterProfile.FirstConsecutiveStaticIP (with VNET Subnet specification) '%s' is an invalid IP address", a.MasterProfile.FirstConsecutiveStaticIP)
+	}
+	return errs
 }
 
 // GetVNETSubnetIDComponents extract subscription, resourcegroup, vnetname, subnetname from the vnetSubnetID
@@ -1,7 +1,10 @@
 package vlabs
 
 import (
+	"encoding/json"
 	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api/common"
 )
 
 func Test_OrchestratorProfile_Validate(t *testing.T) {
@@ -18,6 +21,15 @@ func Test_OrchestratorProfile_Validate(t *testing.T) {
 	if err := o.Validate(); err == nil {
 		t.Errorf("should error when KubernetesConfig populated for non-Kubernetes OrchestratorType")
 	}
+
+	o = &OrchestratorProfile{}
+	if err := json.Unmarshal([]byte(`{"orchestratorType": "Nomad"}`), o); err != nil {
+		t.Fatalf("unknown orchestrators should be reported by Validate, not by json.Unmarshal: %v", err)
+	}
+	errs := o.validate("properties.orchestratorProfile")
+	if len(errs) != 1 || errs[0].Path != "properties.orchestratorProfile.orchestratorType" || errs[0].Code != common.ErrorCodeInvalidValue {
+		t.Errorf("expected an invalid value error on properties.orchestratorProfile.orchestratorType, got %v", errs)
+	}
 }
 
 func Test_KubernetesConfig_Validate(t *testing.T) {
@@ -46,7 +58,7 @@ func Test_Properties_ValidateNetworkPolicy(t *testing.T) {
 	for _, policy := range NetworkPolicyValues {
 		p.OrchestratorProfile.KubernetesConfig = &KubernetesConfig{}
 		p.OrchestratorProfile.KubernetesConfig.NetworkPolicy = policy
-		if err := p.validateNetworkPolicy(); err != nil {
+		if errs := p.validateNetworkPolicy(); len(errs) > 0 {
 			t.Errorf(
 				"should not error on networkPolicy=\"%s\"",
 				policy,
@@ -55,7 +67,7 @@ func Test_Properties_ValidateNetworkPolicy(t *testing.T) {
 	}
 
 	p.OrchestratorProfile.KubernetesConfig.NetworkPolicy = "not-existing"
-	if err := p.validateNetworkPolicy(); err == nil {
+	if errs := p.validateNetworkPolicy(); len(errs) == 0 {
 		t.Errorf(
 			"should error on invalid networkPolicy",
 		)
@@ -67,9 +79,55 @@ func Test_Properties_ValidateNetworkPolicy(t *testing.T) {
 			OSType: Windows,
 		},
 	}
-	if err := p.validateNetworkPolicy(); err == nil {
+	if errs := p.validateNetworkPolicy(); len(errs) == 0 {
 		t.Errorf(
 			"should error on calico for windows clusters",
 		)
 	}
 }
+
+func Test_Properties_ValidateAllErrors(t *testing.T) {
+	p := &Properties{
+		OrchestratorProfile: &OrchestratorProfile{OrchestratorType: Kubernetes},
+		MasterProfile:       &MasterProfile{Count: 2, DNSPrefix: "mydnsprefix", VMSize: "Standard_D2_v2"},
+		AgentPoolProfiles: []*AgentPoolProfile{
+			{Name: "agentpool1", Count: 3, VMSize: "Standard_D2_v2", AvailabilityProfile: AvailabilitySet},
+			{Name: "agentpool1", Count: 3, VMSize: "Standard_D2_v2", AvailabilityProfile: AvailabilitySet},
+			{Name: "agentpool3", Count: 3, AvailabilityProfile: AvailabilitySet},
+		},
+		LinuxProfile: &LinuxProfile{AdminUsername: "azureuser"},
+		ServicePrincipalProfile: &ServicePrincipalProfile{
+			ClientID: "clientID",
+			Secret:   "secret",
+		},
+	}
+	p.LinuxProfile.SSH.PublicKeys = []struct {
+		KeyData string `json:"keyData"`
+	}{{KeyData: "ssh-rsa AAAA"}}
+
+	err := p.Validate()
+	errs, ok := err.(common.ValidationErrors)
+	if !ok {
+		t.Fatalf("expected common.ValidationErrors, got %v", err)
+	}
+
+	expected := []common.ValidationError{
+		{Path: "properties.masterProfile.count", Code: common.ErrorCodeInvalidValue},
+		{Path: "properties.agentPoolProfiles[1].name", Code: common.ErrorCodeDuplicate},
+		{Path: "properties.agentPoolProfiles[2].vmSize", Code: common.ErrorCodeRequired},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, e := range expected {
+		if errs[i].Path != e.Path || errs[i].Code != e.Code {
+			t.Errorf("expected error %d to be %s %s, got %s %s", i, e.Path, e.Code, errs[i].Path, errs[i].Code)
+		}
+	}
+
+	p.MasterProfile.Count = 1
+	p.AgentPoolProfiles = p.AgentPoolProfiles[:1]
+	if err := p.Validate(); err != nil {
+		t.Errorf("should not error on a valid api model: %v", err)
+	}
+}
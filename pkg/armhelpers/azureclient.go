@@ -8,10 +8,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/azure-sdk-for-go/arm/disk"
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/Azure/azure-sdk-for-go/arm/resources/subscriptions"
@@ -19,8 +19,7 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/Azure/go-autorest/autorest/to"
-	log "github.com/Sirupsen/logrus"
+	"github.com/Sirupsen/logrus"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/Azure/acs-engine/pkg/acsengine"
@@ -44,19 +43,26 @@ var (
 type AzureClient struct {
 	environment azure.Environment
 
-	deploymentsClient     resources.DeploymentsClient
-	resourcesClient       resources.GroupClient
-	storageAccountsClient storage.AccountsClient
-	interfacesClient      network.InterfacesClient
-	groupsClient          resources.GroupsClient
-	providersClient       resources.ProvidersClient
-	subscriptionsClient   subscriptions.GroupClient
-	virtualMachinesClient compute.VirtualMachinesClient
+	deploymentsClient          resources.DeploymentsClient
+	deploymentOperationsClient resources.DeploymentOperationsClient
+	resourcesClient            resources.GroupClient
+	storageAccountsClient      storage.AccountsClient
+	interfacesClient           network.InterfacesClient
+	groupsClient               resources.GroupsClient
+	providersClient            resources.ProvidersClient
+	subscriptionsClient        subscriptions.GroupClient
+	virtualMachinesClient      compute.VirtualMachinesClient
+	disksClient                disk.DisksClient
+	virtualMachineSizesClient  compute.VirtualMachineSizesClient
+	usageClient                compute.UsageClient
+
+	retryPolicy RetryPolicy
+	logger      *logrus.Entry
 }
 
 // NewAzureClientWithDeviceAuth returns an AzureClient by having a user complete a device authentication flow
-func NewAzureClientWithDeviceAuth(env azure.Environment, subscriptionID string) (*AzureClient, error) {
-	oauthConfig, tenantID, err := getOAuthConfig(env, subscriptionID)
+func NewAzureClientWithDeviceAuth(logger *logrus.Entry, env azure.Environment, subscriptionID string) (*AzureClient, error) {
+	oauthConfig, tenantID, err := getOAuthConfig(logger, env, subscriptionID)
 	if err != nil {
 		return nil, err
 	}
@@ -67,26 +73,26 @@ func NewAzureClientWithDeviceAuth(env azure.Environment, subscriptionID string)
 	}
 	cachePath := filepath.Join(home, ApplicationDir, "cache", fmt.Sprintf("%s_%s.token.json", tenantID, AcsEngineClientID))
 
-	rawToken, err := tryLoadCachedToken(cachePath)
+	rawToken, err := tryLoadCachedToken(logger, cachePath)
 	if err != nil {
 		return nil, err
 	}
 
 	var armSpt *adal.ServicePrincipalToken
 	if rawToken != nil {
-		armSpt, err = adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, AcsEngineClientID, env.ServiceManagementEndpoint, *rawToken, tokenCallback(cachePath))
+		armSpt, err = adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, AcsEngineClientID, env.ServiceManagementEndpoint, *rawToken, tokenCallback(logger, cachePath))
 		if err != nil {
 			return nil, err
 		}
 		err = armSpt.Refresh()
 		if err != nil {
-			log.Warnf("Refresh token failed. Will fallback to device auth. %q", err)
+			logger.Warnf("Refresh token failed. Will fallback to device auth. %q", err)
 		} else {
 			adSpt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, AcsEngineClientID, env.GraphEndpoint, armSpt.Token)
 			if err != nil {
 				return nil, err
 			}
-			return getClient(env, subscriptionID, armSpt, adSpt)
+			return getClient(logger, env, subscriptionID, armSpt, adSpt)
 		}
 	}
 
@@ -96,13 +102,13 @@ func NewAzureClientWithDeviceAuth(env azure.Environment, subscriptionID string)
 	if err != nil {
 		return nil, err
 	}
-	log.Warnln(*deviceCode.Message)
+	logger.Warnln(*deviceCode.Message)
 	deviceToken, err := adal.WaitForUserCompletion(client, deviceCode)
 	if err != nil {
 		return nil, err
 	}
 
-	armSpt, err = adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, AcsEngineClientID, env.ServiceManagementEndpoint, *deviceToken, tokenCallback(cachePath))
+	armSpt, err = adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, AcsEngineClientID, env.ServiceManagementEndpoint, *deviceToken, tokenCallback(logger, cachePath))
 	if err != nil {
 		return nil, err
 	}
@@ -115,12 +121,12 @@ func NewAzureClientWithDeviceAuth(env azure.Environment, subscriptionID string)
 		return nil, err
 	}
 
-	return getClient(env, subscriptionID, armSpt, adSpt)
+	return getClient(logger, env, subscriptionID, armSpt, adSpt)
 }
 
 // NewAzureClientWithClientSecret returns an AzureClient via client_id and client_secret
-func NewAzureClientWithClientSecret(env azure.Environment, subscriptionID, clientID, clientSecret string) (*AzureClient, error) {
-	oauthConfig, _, err := getOAuthConfig(env, subscriptionID)
+func NewAzureClientWithClientSecret(logger *logrus.Entry, env azure.Environment, subscriptionID, clientID, clientSecret string) (*AzureClient, error) {
+	oauthConfig, _, err := getOAuthConfig(logger, env, subscriptionID)
 	if err != nil {
 		return nil, err
 	}
@@ -134,12 +140,12 @@ func NewAzureClientWithClientSecret(env azure.Environment, subscriptionID, clien
 		return nil, err
 	}
 
-	return getClient(env, subscriptionID, armSpt, adSpt)
+	return getClient(logger, env, subscriptionID, armSpt, adSpt)
 }
 
 // NewAzureClientWithClientCertificate returns an AzureClient via client_id and jwt certificate assertion
-func NewAzureClientWithClientCertificate(env azure.Environment, subscriptionID, clientID, certificatePath, privateKeyPath string) (*AzureClient, error) {
-	oauthConfig, _, err := getOAuthConfig(env, subscriptionID)
+func NewAzureClientWithClientCertificate(logger *logrus.Entry, env azure.Environment, subscriptionID, clientID, certificatePath, privateKeyPath string) (*AzureClient, error) {
+	oauthConfig, _, err := getOAuthConfig(logger, env, subscriptionID)
 	if err != nil {
 		return nil, err
 	}
@@ -173,22 +179,22 @@ func NewAzureClientWithClientCertificate(env azure.Environment, subscriptionID,
 		return nil, err
 	}
 
-	return getClient(env, subscriptionID, armSpt, adSpt)
+	return getClient(logger, env, subscriptionID, armSpt, adSpt)
 }
 
-func tokenCallback(path string) func(t adal.Token) error {
+func tokenCallback(logger *logrus.Entry, path string) func(t adal.Token) error {
 	return func(token adal.Token) error {
 		err := adal.SaveToken(path, 0600, token)
 		if err != nil {
 			return err
 		}
-		log.Debugf("Saved token to cache. path=%q", path)
+		logger.Debugf("Saved token to cache. path=%q", path)
 		return nil
 	}
 }
 
-func tryLoadCachedToken(cachePath string) (*adal.Token, error) {
-	log.Debugf("Attempting to load token from cache. path=%q", cachePath)
+func tryLoadCachedToken(logger *logrus.Entry, cachePath string) (*adal.Token, error) {
+	logger.Debugf("Attempting to load token from cache. path=%q", cachePath)
 
 	// Check for file not found so we can suppress the file not found error
 	// LoadToken doesn't discern and returns error either way
@@ -207,8 +213,8 @@ func tryLoadCachedToken(cachePath string) (*adal.Token, error) {
 	return token, nil
 }
 
-func getOAuthConfig(env azure.Environment, subscriptionID string) (*adal.OAuthConfig, string, error) {
-	tenantID, err := acsengine.GetTenantID(env, subscriptionID)
+func getOAuthConfig(logger *logrus.Entry, env azure.Environment, subscriptionID string) (*adal.OAuthConfig, string, error) {
+	tenantID, err := acsengine.GetTenantID(logger, env, subscriptionID)
 	if err != nil {
 		return nil, "", err
 	}
@@ -221,68 +227,43 @@ func getOAuthConfig(env azure.Environment, subscriptionID string) (*adal.OAuthCo
 	return oauthConfig, tenantID, nil
 }
 
-func getClient(env azure.Environment, subscriptionID string, armSpt *adal.ServicePrincipalToken, adSpt *adal.ServicePrincipalToken) (*AzureClient, error) {
+func getClient(logger *logrus.Entry, env azure.Environment, subscriptionID string, armSpt *adal.ServicePrincipalToken, adSpt *adal.ServicePrincipalToken) (*AzureClient, error) {
 	c := &AzureClient{
-		environment:           env,
-		deploymentsClient:     resources.NewDeploymentsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		resourcesClient:       resources.NewGroupClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		storageAccountsClient: storage.NewAccountsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		interfacesClient:      network.NewInterfacesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		groupsClient:          resources.NewGroupsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		providersClient:       resources.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
-		virtualMachinesClient: compute.NewVirtualMachinesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		environment:                env,
+		deploymentsClient:          resources.NewDeploymentsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		deploymentOperationsClient: resources.NewDeploymentOperationsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		resourcesClient:            resources.NewGroupClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		storageAccountsClient:      storage.NewAccountsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		interfacesClient:           network.NewInterfacesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		groupsClient:               resources.NewGroupsClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		providersClient:            resources.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		virtualMachinesClient:      compute.NewVirtualMachinesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		disksClient:                disk.NewDisksClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		virtualMachineSizesClient:  compute.NewVirtualMachineSizesClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		usageClient:                compute.NewUsageClientWithBaseURI(env.ResourceManagerEndpoint, subscriptionID),
+		logger:                     logger,
 	}
 
 	authorizer := autorest.NewBearerAuthorizer(armSpt)
 	c.deploymentsClient.Authorizer = authorizer
+	c.deploymentOperationsClient.Authorizer = authorizer
 	c.resourcesClient.Authorizer = authorizer
 	c.storageAccountsClient.Authorizer = authorizer
 	c.interfacesClient.Authorizer = authorizer
 	c.groupsClient.Authorizer = authorizer
 	c.providersClient.Authorizer = authorizer
 	c.virtualMachinesClient.Authorizer = authorizer
+	c.disksClient.Authorizer = authorizer
+	c.virtualMachineSizesClient.Authorizer = authorizer
+	c.usageClient.Authorizer = authorizer
 
 	c.deploymentsClient.PollingDelay = time.Second * 5
 
-	err := c.ensureProvidersRegistered(subscriptionID)
-	if err != nil {
-		return nil, err
-	}
+	c.SetRetryPolicy(DefaultRetryPolicy)
 
 	return c, nil
 }
 
-func (az *AzureClient) ensureProvidersRegistered(subscriptionID string) error {
-	registeredProviders, err := az.providersClient.List(to.Int32Ptr(100), "")
-	if err != nil {
-		return err
-	}
-	if registeredProviders.Value == nil {
-		return fmt.Errorf("Providers list was nil. subscription=%q", subscriptionID)
-	}
-
-	m := make(map[string]bool)
-	for _, provider := range *registeredProviders.Value {
-		m[strings.ToLower(to.String(provider.Namespace))] = to.String(provider.RegistrationState) == "Registered"
-	}
-
-	for _, provider := range RequiredResourceProviders {
-		registered, ok := m[strings.ToLower(provider)]
-		if !ok {
-			return fmt.Errorf("Unknown resource provider %q", provider)
-		}
-		if registered {
-			log.Debugf("Already registered for %q", provider)
-		} else {
-			log.Info("Registering subscription to resource provider. provider=%q subscription=%q", provider, subscriptionID)
-			if _, err := az.providersClient.Register(provider); err != nil {
-				return err
-			}
-		}
-	}
-	return nil
-}
-
 func parseRsaPrivateKey(path string) (*rsa.PrivateKey, error) {
 	privateKeyData, err := ioutil.ReadFile(path)
 	if err != nil {
@@ -1,12 +1,31 @@
 package armhelpers
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/azure-sdk-for-go/arm/disk"
 )
 
-// ListVirtualMachines returns (the first page of) the machines in the specified resource group.
+// ListVirtualMachines returns all the machines in the specified resource group, following the result pages.
 func (az *AzureClient) ListVirtualMachines(resourceGroup string) (compute.VirtualMachineListResult, error) {
-	return az.virtualMachinesClient.List(resourceGroup)
+	page, err := az.virtualMachinesClient.List(resourceGroup)
+	if err != nil {
+		return page, err
+	}
+
+	vms := []compute.VirtualMachine{}
+	for {
+		if page.Value != nil {
+			vms = append(vms, *page.Value...)
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			return compute.VirtualMachineListResult{Value: &vms}, nil
+		}
+		if page, err = az.virtualMachinesClient.ListNextResults(page); err != nil {
+			return page, err
+		}
+	}
 }
 
 // GetVirtualMachine returns the specified machine in the specified resource group.
@@ -14,7 +33,51 @@ func (az *AzureClient) GetVirtualMachine(resourceGroup, name string) (compute.Vi
 	return az.virtualMachinesClient.Get(resourceGroup, name, "")
 }
 
+// GetVirtualMachineInstanceView returns the instance view, with the power state, of the specified machine.
+func (az *AzureClient) GetVirtualMachineInstanceView(resourceGroup, name string) (compute.VirtualMachineInstanceView, error) {
+	vm, err := az.virtualMachinesClient.Get(resourceGroup, name, compute.InstanceView)
+	if err != nil {
+		return compute.VirtualMachineInstanceView{}, err
+	}
+	if vm.VirtualMachineProperties == nil || vm.InstanceView == nil {
+		return compute.VirtualMachineInstanceView{}, nil
+	}
+	return *vm.InstanceView, nil
+}
+
 // DeleteVirtualMachine handles deletion of a CRP/VMAS VM (aka, not a VMSS VM).
-func (az *AzureClient) DeleteVirtualMachine(resourceGroup, name string, cancel <-chan struct{}) (<-chan compute.OperationStatusResponse, <-chan error) {
-	return az.virtualMachinesClient.Delete(resourceGroup, name, cancel)
+func (az *AzureClient) DeleteVirtualMachine(ctx context.Context, resourceGroup, name string) (<-chan compute.OperationStatusResponse, <-chan error) {
+	return az.virtualMachinesClient.Delete(resourceGroup, name, ctx.Done())
+}
+
+// DeleteManagedDisk deletes the specified managed disk, which must not be attached to a VM.
+func (az *AzureClient) DeleteManagedDisk(ctx context.Context, resourceGroup, diskName string) (<-chan disk.OperationStatusResponse, <-chan error) {
+	return az.disksClient.Delete(resourceGroup, diskName, ctx.Done())
+}
+
+// ListVirtualMachineSizes returns the VM sizes available in the specified location.
+func (az *AzureClient) ListVirtualMachineSizes(location string) (compute.VirtualMachineSizeListResult, error) {
+	return az.virtualMachineSizesClient.List(location)
+}
+
+// ListUsages returns all the compute usages and limits, such as the cores per VM family, of the
+// subscription in the specified location.
+func (az *AzureClient) ListUsages(location string) (compute.ListUsagesResult, error) {
+	page, err := az.usageClient.List(location)
+	if err != nil {
+		return page, err
+	}
+
+	usages := []compute.Usage{}
+	for {
+		if page.Value != nil {
+			usages = append(usages, *page.Value...)
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			return compute.ListUsagesResult{Value: &usages}, nil
+		}
+		if page, err = az.usageClient.ListNextResults(page); err != nil {
+			return page, err
+		}
+	}
 }
@@ -0,0 +1,36 @@
+package armhelpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+)
+
+func Test_ListVirtualMachinesPages(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"value": [{"name": "k8s-agentpool1-22551669-1"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"value": [{"name": "k8s-master-22551669-0"}, {"name": "k8s-agentpool1-22551669-0"}], "nextLink": "%s%s?page=2"}`,
+			server.URL, r.URL.Path)
+	}))
+	defer server.Close()
+
+	az := &AzureClient{virtualMachinesClient: compute.NewVirtualMachinesClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")}
+	result, err := az.ListVirtualMachines("testrg")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if result.Value == nil || len(*result.Value) != 3 {
+		t.Fatalf("expected the VMs of both pages to be listed, got %v", result.Value)
+	}
+	if name := *(*result.Value)[2].Name; name != "k8s-agentpool1-22551669-1" {
+		t.Fatalf("incorrect VM of the second page: %s", name)
+	}
+}
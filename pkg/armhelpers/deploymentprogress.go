@@ -0,0 +1,118 @@
+package armhelpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// DeploymentProgressInterval is how often the operations of a running deployment are polled
+var DeploymentProgressInterval = 15 * time.Second
+
+// listDeploymentOperations returns all the operations of the deployment, following the result pages
+func (az *AzureClient) listDeploymentOperations(resourceGroupName, deploymentName string) ([]resources.DeploymentOperation, error) {
+	page, err := az.deploymentOperationsClient.List(resourceGroupName, deploymentName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := []resources.DeploymentOperation{}
+	for {
+		if page.Value != nil {
+			operations = append(operations, *page.Value...)
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			return operations, nil
+		}
+		if page, err = az.deploymentOperationsClient.ListNextResults(page); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// deploymentProgress reports the provisioning state changes of the resources of a deployment
+type deploymentProgress struct {
+	deploymentName string
+	states         map[string]string
+	started        map[string]time.Time
+	now            func() time.Time
+}
+
+func newDeploymentProgress(deploymentName string) *deploymentProgress {
+	return &deploymentProgress{
+		deploymentName: deploymentName,
+		states:         map[string]string{},
+		started:        map[string]time.Time{},
+		now:            time.Now,
+	}
+}
+
+// update returns a line for each operation whose provisioning state changed since the previous update
+func (p *deploymentProgress) update(operations []resources.DeploymentOperation) []string {
+	changes := []string{}
+	for _, operation := range operations {
+		if operation.Properties == nil {
+			continue
+		}
+		id := to.String(operation.OperationID)
+		state := to.String(operation.Properties.ProvisioningState)
+		if p.states[id] == state {
+			continue
+		}
+
+		started, ok := p.started[id]
+		if !ok {
+			started = p.now()
+			p.started[id] = started
+		}
+		p.states[id] = state
+
+		changes = append(changes, fmt.Sprintf("%s: %s after %s",
+			operationResource(operation), state, p.now().Sub(started)/time.Second*time.Second))
+	}
+	return changes
+}
+
+// failedOperationMessages returns the status message of each failed operation
+func failedOperationMessages(operations []resources.DeploymentOperation) []string {
+	messages := []string{}
+	for _, operation := range operations {
+		if operation.Properties == nil || to.String(operation.Properties.ProvisioningState) != "Failed" {
+			continue
+		}
+		statusMessage := ""
+		if operation.Properties.StatusMessage != nil {
+			b, _ := json.Marshal(*operation.Properties.StatusMessage)
+			statusMessage = string(b)
+		}
+		messages = append(messages, fmt.Sprintf("%s: %s %s",
+			operationResource(operation), to.String(operation.Properties.StatusCode), statusMessage))
+	}
+	return messages
+}
+
+// operationResource returns the type and name of the resource a deployment operation targets
+func operationResource(operation resources.DeploymentOperation) string {
+	target := operation.Properties.TargetResource
+	if target == nil {
+		// the last operation of a deployment has no target resource
+		return fmt.Sprintf("operation %s", to.String(operation.OperationID))
+	}
+	return fmt.Sprintf("%s %s", to.String(target.ResourceType), to.String(target.ResourceName))
+}
+
+// reportDeploymentProgress logs the state changes of the resources of the deployment
+func (az *AzureClient) reportDeploymentProgress(resourceGroupName string, progress *deploymentProgress) {
+	operations, err := az.listDeploymentOperations(resourceGroupName, progress.deploymentName)
+	if err != nil {
+		// the operations are only listed once ARM has accepted the deployment
+		az.logger.Debugf("Failed to list deployment operations. deployment=%q: %s", progress.deploymentName, err.Error())
+		return
+	}
+	for _, change := range progress.update(operations) {
+		az.logger.Infof("Deployment %q: %s", progress.deploymentName, change)
+	}
+}
@@ -0,0 +1,64 @@
+package armhelpers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func deploymentOperation(id, resourceType, name, state string) resources.DeploymentOperation {
+	return resources.DeploymentOperation{
+		OperationID: to.StringPtr(id),
+		Properties: &resources.DeploymentOperationProperties{
+			ProvisioningState: to.StringPtr(state),
+			TargetResource: &resources.TargetResource{
+				ResourceType: to.StringPtr(resourceType),
+				ResourceName: to.StringPtr(name),
+			},
+		},
+	}
+}
+
+func Test_DeploymentProgress(t *testing.T) {
+	now := time.Now()
+	progress := newDeploymentProgress("test")
+	progress.now = func() time.Time { return now }
+
+	changes := progress.update([]resources.DeploymentOperation{
+		deploymentOperation("1", "Microsoft.Compute/virtualMachines", "k8s-master-22551669-0", "Running"),
+		deploymentOperation("2", "Microsoft.Network/networkInterfaces", "k8s-master-22551669-nic-0", "Succeeded"),
+	})
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %v", changes)
+	}
+
+	now = now.Add(90 * time.Second)
+	changes = progress.update([]resources.DeploymentOperation{
+		deploymentOperation("1", "Microsoft.Compute/virtualMachines", "k8s-master-22551669-0", "Succeeded"),
+		deploymentOperation("2", "Microsoft.Network/networkInterfaces", "k8s-master-22551669-nic-0", "Succeeded"),
+	})
+	expected := "Microsoft.Compute/virtualMachines k8s-master-22551669-0: Succeeded after 1m30s"
+	if len(changes) != 1 || changes[0] != expected {
+		t.Fatalf("incorrect changes. expected=[%s] actual=%v", expected, changes)
+	}
+}
+
+func Test_FailedOperationMessages(t *testing.T) {
+	failed := deploymentOperation("1", "Microsoft.Compute/virtualMachines", "k8s-agentpool1-22551669-0", "Failed")
+	failed.Properties.StatusCode = to.StringPtr("Conflict")
+	failed.Properties.StatusMessage = &map[string]interface{}{"error": map[string]interface{}{"code": "OperationNotAllowed"}}
+
+	messages := failedOperationMessages([]resources.DeploymentOperation{
+		deploymentOperation("2", "Microsoft.Network/networkInterfaces", "k8s-agentpool1-22551669-nic-0", "Succeeded"),
+		failed,
+	})
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 message, got %v", messages)
+	}
+	if !strings.Contains(messages[0], "k8s-agentpool1-22551669-0: Conflict") || !strings.Contains(messages[0], "OperationNotAllowed") {
+		t.Fatalf("incorrect message: %s", messages[0])
+	}
+}
@@ -1,15 +1,18 @@
 package armhelpers
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
-	"github.com/prometheus/common/log"
+	"github.com/Azure/go-autorest/autorest/to"
 )
 
-// DeployTemplate implements the TemplateDeployer interface for the AzureClient client
-func (az *AzureClient) DeployTemplate(resourceGroupName, deploymentName string, template map[string]interface{}, parameters map[string]interface{}, cancel <-chan struct{}) (*resources.DeploymentExtended, error) {
+// DeployTemplate implements the TemplateDeployer interface for the AzureClient client.
+// When the context is done before the deployment completes, the deployment is canceled in ARM.
+func (az *AzureClient) DeployTemplate(ctx context.Context, resourceGroupName, deploymentName string, template map[string]interface{}, parameters map[string]interface{}) (*resources.DeploymentExtended, error) {
 	// this is needed because either ARM or the SDK can't distinguish between past
 	// deployments and current deployments with the same deploymentName.
 	uniqueSuffix := fmt.Sprintf("-%d", time.Now().Unix())
@@ -23,19 +26,57 @@ func (az *AzureClient) DeployTemplate(resourceGroupName, deploymentName string,
 		},
 	}
 
-	log.Infof("Starting ARM Deployment. This will take some time. deployment=%q", deploymentName)
+	az.logger.Infof("Starting ARM Deployment. This will take some time. deployment=%q", deploymentName)
 
 	resChan, errChan := az.deploymentsClient.CreateOrUpdate(
 		resourceGroupName,
 		deploymentName,
 		deployment,
-		cancel)
-	if err := <-errChan; err != nil {
+		ctx.Done())
+
+	// report the progress of the resources while the deployment runs
+	progress := newDeploymentProgress(deploymentName)
+	ticker := time.NewTicker(DeploymentProgressInterval)
+	defer ticker.Stop()
+	var err error
+	for done := false; !done; {
+		select {
+		case err = <-errChan:
+			done = true
+		case <-ctx.Done():
+			done = true
+		case <-ticker.C:
+			az.reportDeploymentProgress(resourceGroupName, progress)
+		}
+	}
+	az.reportDeploymentProgress(resourceGroupName, progress)
+
+	if ctx.Err() != nil {
+		// no longer waiting for the deployment does not stop it
+		az.logger.Warnf("Canceling ARM Deployment. deployment=%q", deploymentName)
+		if _, cancelErr := az.deploymentsClient.Cancel(resourceGroupName, deploymentName); cancelErr != nil {
+			az.logger.Errorf("Failed to cancel deployment %q: %s", deploymentName, cancelErr.Error())
+		}
+		return nil, fmt.Errorf("deployment %q canceled: %s", deploymentName, ctx.Err().Error())
+	}
+
+	if err != nil {
+		operations, listErr := az.listDeploymentOperations(resourceGroupName, deploymentName)
+		if listErr != nil {
+			return nil, err
+		}
+		messages := failedOperationMessages(operations)
+		for _, message := range messages {
+			az.logger.Errorf("Deployment %q failed: %s", deploymentName, message)
+		}
+		if len(messages) > 0 {
+			return nil, fmt.Errorf("%s: %s", err.Error(), strings.Join(messages, "; "))
+		}
 		return nil, err
 	}
 	res := <-resChan
 
-	log.Infof("Finished ARM Deployment. deployment=%q. res=%q", deploymentName, res)
+	az.logger.Infof("Finished ARM Deployment. deployment=%q. res=%q", deploymentName, res)
 
 	return &res, nil
 }
@@ -55,3 +96,27 @@ func (az *AzureClient) ValidateTemplate(
 	}
 	return az.deploymentsClient.Validate(resourceGroupName, deploymentName, deployment)
 }
+
+// ValidationErrors returns the errors of a template validation result, including the nested
+// details, one per line. It returns nothing for a valid template.
+func ValidationErrors(result resources.DeploymentValidateResult) []string {
+	if result.Error == nil {
+		return nil
+	}
+	return flattenManagementError(*result.Error, "")
+}
+
+func flattenManagementError(managementError resources.ManagementErrorWithDetails, indent string) []string {
+	message := fmt.Sprintf("%s%s: %s", indent, to.String(managementError.Code), to.String(managementError.Message))
+	if managementError.Target != nil {
+		message = fmt.Sprintf("%s (target: %s)", message, *managementError.Target)
+	}
+
+	errors := []string{message}
+	if managementError.Details != nil {
+		for _, detail := range *managementError.Details {
+			errors = append(errors, flattenManagementError(detail, indent+"  ")...)
+		}
+	}
+	return errors
+}
@@ -0,0 +1,41 @@
+package armhelpers
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func Test_ValidationErrors(t *testing.T) {
+	if errors := ValidationErrors(resources.DeploymentValidateResult{}); len(errors) != 0 {
+		t.Fatalf("expected no error for a valid template, got %v", errors)
+	}
+
+	result := resources.DeploymentValidateResult{
+		Error: &resources.ManagementErrorWithDetails{
+			Code:    to.StringPtr("InvalidTemplateDeployment"),
+			Message: to.StringPtr("The template deployment is not valid"),
+			Details: &[]resources.ManagementErrorWithDetails{
+				{
+					Code:    to.StringPtr("QuotaExceeded"),
+					Message: to.StringPtr("Operation results in exceeding quota limits of Core"),
+					Target:  to.StringPtr("k8s-agentpool1-22551669-0"),
+				},
+			},
+		},
+	}
+	expected := []string{
+		"InvalidTemplateDeployment: The template deployment is not valid",
+		"  QuotaExceeded: Operation results in exceeding quota limits of Core (target: k8s-agentpool1-22551669-0)",
+	}
+	errors := ValidationErrors(result)
+	if len(errors) != len(expected) {
+		t.Fatalf("incorrect number of errors. expected=%d actual=%d", len(expected), len(errors))
+	}
+	for i := range expected {
+		if errors[i] != expected[i] {
+			t.Fatalf("incorrect error. expected=%q actual=%q", expected[i], errors[i])
+		}
+	}
+}
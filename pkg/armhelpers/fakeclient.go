@@ -0,0 +1,793 @@
+package armhelpers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/azure-sdk-for-go/arm/disk"
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// Compiler to verify the fakes implement the interfaces they fake
+var _ ACSEngineClient = &FakeACSEngineClient{}
+var _ ACSStorageClient = &FakeStorageClient{}
+
+// FakeACSEngineClient implements the `ACSEngineClient` interface.
+// This client models resource groups, VMs, NICs, managed disks, blobs and deployments in memory,
+// so that the operations can be tested offline. Every call is recorded, every call can be made to
+// fail by setting Failures, and every call takes at least Latency.
+type FakeACSEngineClient struct {
+	sync.Mutex
+
+	// ResourceGroups are indexed by lower case name, as resource group names are case insensitive
+	ResourceGroups map[string]*FakeResourceGroup
+
+	// Calls records the calls made to the client, and to its storage clients, in order
+	Calls []FakeCall
+
+	// Failures is the error returned by each call of the method it is indexed by (e.g. "DeployTemplate")
+	Failures map[string]error
+
+	// Latency is how long each call takes
+	Latency time.Duration
+
+	// VirtualMachineSizes are the VM sizes available in each location
+	VirtualMachineSizes map[string][]compute.VirtualMachineSize
+
+	// Usages are the compute usages and limits of the subscription in each location
+	Usages map[string][]compute.Usage
+
+	// Providers are the registration states of the subscription, indexed by resource provider namespace.
+	// A registration completes on the next listing of the providers.
+	Providers map[string]string
+
+	// ValidationError, when set, is the error of every template validation
+	ValidationError *resources.ManagementErrorWithDetails
+
+	// OnDeploy, when set, is called for each successful deployment, to let a test create
+	// the resources the template would create
+	OnDeploy func(resourceGroup *FakeResourceGroup, deployment *FakeDeployment) error
+}
+
+// FakeCall is a call made to a FakeACSEngineClient or to one of its storage clients
+type FakeCall struct {
+	Method string
+	Args   []string
+}
+
+// FakeResourceGroup is a resource group of a FakeACSEngineClient
+type FakeResourceGroup struct {
+	Name              string
+	Location          string
+	Tags              map[string]*string
+	VirtualMachines   map[string]compute.VirtualMachine
+	NetworkInterfaces map[string]bool
+	ManagedDisks      map[string]bool
+	StorageAccounts   map[string]*FakeStorageClient
+	Deployments       []*FakeDeployment
+
+	// OtherResources are the types of the other resources, such as load balancers, indexed by lower case name
+	OtherResources map[string]string
+}
+
+// FakeDeployment is a template deployed by a FakeACSEngineClient
+type FakeDeployment struct {
+	Name       string
+	Template   map[string]interface{}
+	Parameters map[string]interface{}
+}
+
+// FakeStorageClient implements the `ACSStorageClient` interface for a storage account of a FakeACSEngineClient
+type FakeStorageClient struct {
+	AccountName string
+
+	// Blobs are indexed by "<container>/<blob>"
+	Blobs map[string]bool
+
+	client *FakeACSEngineClient
+}
+
+// NewFakeACSEngineClient returns a FakeACSEngineClient without any resource
+func NewFakeACSEngineClient() *FakeACSEngineClient {
+	return &FakeACSEngineClient{
+		ResourceGroups: map[string]*FakeResourceGroup{},
+		Calls:          []FakeCall{},
+		Failures:       map[string]error{},
+
+		VirtualMachineSizes: map[string][]compute.VirtualMachineSize{},
+		Usages:              map[string][]compute.Usage{},
+		Providers: map[string]string{
+			"Microsoft.Compute":  "Registered",
+			"Microsoft.Storage":  "Registered",
+			"Microsoft.Network":  "Registered",
+			"Microsoft.KeyVault": "Registered",
+		},
+	}
+}
+
+// AddResourceGroup returns the specified resource group, creating it if needed
+func (f *FakeACSEngineClient) AddResourceGroup(name, location string) *FakeResourceGroup {
+	f.Lock()
+	defer f.Unlock()
+
+	return f.addResourceGroup(name, location)
+}
+
+// AddVirtualMachine adds the VM to the resource group, together with the NICs, managed disks
+// and blobs its profiles reference
+func (f *FakeACSEngineClient) AddVirtualMachine(resourceGroup string, vm compute.VirtualMachine) {
+	f.Lock()
+	defer f.Unlock()
+
+	rg := f.addResourceGroup(resourceGroup, "")
+	rg.VirtualMachines[strings.ToLower(to.String(vm.Name))] = vm
+	if vm.VirtualMachineProperties == nil {
+		return
+	}
+
+	if networkProfile := vm.NetworkProfile; networkProfile != nil && networkProfile.NetworkInterfaces != nil {
+		for _, nic := range *networkProfile.NetworkInterfaces {
+			if name, err := ResourceName(to.String(nic.ID)); err == nil {
+				rg.NetworkInterfaces[strings.ToLower(name)] = true
+			}
+		}
+	}
+
+	if storageProfile := vm.StorageProfile; storageProfile != nil {
+		if osDisk := storageProfile.OsDisk; osDisk != nil {
+			f.addDisk(rg, osDisk.Vhd, osDisk.ManagedDisk)
+		}
+		if storageProfile.DataDisks != nil {
+			for _, dataDisk := range *storageProfile.DataDisks {
+				f.addDisk(rg, dataDisk.Vhd, dataDisk.ManagedDisk)
+			}
+		}
+	}
+}
+
+// AddBlob adds a blob to the specified storage account
+func (f *FakeACSEngineClient) AddBlob(resourceGroup, accountName, container, blob string) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.storageAccount(f.addResourceGroup(resourceGroup, ""), accountName).Blobs[container+"/"+blob] = true
+}
+
+// CallCount returns how many times the specified method was called
+func (f *FakeACSEngineClient) CallCount(method string) int {
+	f.Lock()
+	defer f.Unlock()
+
+	count := 0
+	for _, call := range f.Calls {
+		if call.Method == method {
+			count++
+		}
+	}
+	return count
+}
+
+// DeployTemplate records the deployment, after calling OnDeploy if set
+func (f *FakeACSEngineClient) DeployTemplate(ctx context.Context, resourceGroup, name string, template, parameters map[string]interface{}) (*resources.DeploymentExtended, error) {
+	if err := f.callWithContext(ctx, "DeployTemplate", resourceGroup, name); err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	deployment := &FakeDeployment{Name: name, Template: template, Parameters: parameters}
+	if f.OnDeploy != nil {
+		if err := f.OnDeploy(rg, deployment); err != nil {
+			return nil, err
+		}
+	}
+	rg.Deployments = append(rg.Deployments, deployment)
+
+	return &resources.DeploymentExtended{
+		Name: to.StringPtr(name),
+		Properties: &resources.DeploymentPropertiesExtended{
+			ProvisioningState: to.StringPtr("Succeeded"),
+		},
+	}, nil
+}
+
+// ValidateTemplate returns ValidationError in the validation result
+func (f *FakeACSEngineClient) ValidateTemplate(resourceGroup, name string, template, parameters map[string]interface{}) (resources.DeploymentValidateResult, error) {
+	if err := f.call("ValidateTemplate", resourceGroup, name); err != nil {
+		return resources.DeploymentValidateResult{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	if _, err := f.resourceGroup(resourceGroup); err != nil {
+		return resources.DeploymentValidateResult{}, err
+	}
+	return resources.DeploymentValidateResult{Error: f.ValidationError}, nil
+}
+
+// ListProviders returns the Providers, completing the pending registrations
+func (f *FakeACSEngineClient) ListProviders() (resources.ProviderListResult, error) {
+	if err := f.call("ListProviders"); err != nil {
+		return resources.ProviderListResult{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	providers := []resources.Provider{}
+	for namespace, state := range f.Providers {
+		providers = append(providers, resources.Provider{Namespace: to.StringPtr(namespace), RegistrationState: to.StringPtr(state)})
+		if state == "Registering" {
+			f.Providers[namespace] = "Registered"
+		}
+	}
+	return resources.ProviderListResult{Value: &providers}, nil
+}
+
+// RegisterProvider starts the registration of the resource provider
+func (f *FakeACSEngineClient) RegisterProvider(namespace string) (resources.Provider, error) {
+	if err := f.call("RegisterProvider", namespace); err != nil {
+		return resources.Provider{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	for name := range f.Providers {
+		if strings.EqualFold(name, namespace) {
+			f.Providers[name] = "Registering"
+			return resources.Provider{Namespace: to.StringPtr(name), RegistrationState: to.StringPtr("Registering")}, nil
+		}
+	}
+	return resources.Provider{}, notFound("resource provider", namespace)
+}
+
+// EnsureResourceGroup creates the resource group with the tags if it does not exist
+func (f *FakeACSEngineClient) EnsureResourceGroup(resourceGroup, location string, tags map[string]*string) (*resources.Group, error) {
+	if err := f.call("EnsureResourceGroup", resourceGroup, location); err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		rg = f.addResourceGroup(resourceGroup, location)
+		rg.Tags = tags
+	}
+	return fakeGroup(rg), nil
+}
+
+// GetResourceGroup returns the specified resource group
+func (f *FakeACSEngineClient) GetResourceGroup(resourceGroup string) (resources.Group, error) {
+	if err := f.call("GetResourceGroup", resourceGroup); err != nil {
+		return resources.Group{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return resources.Group{}, err
+	}
+	return *fakeGroup(rg), nil
+}
+
+// DeleteResourceGroup deletes the resource group and all its resources
+func (f *FakeACSEngineClient) DeleteResourceGroup(ctx context.Context, resourceGroup string) (<-chan autorest.Response, <-chan error) {
+	resultChan := make(chan autorest.Response, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(resultChan)
+		defer close(errChan)
+		if err := f.callWithContext(ctx, "DeleteResourceGroup", resourceGroup); err != nil {
+			errChan <- err
+			return
+		}
+
+		f.Lock()
+		defer f.Unlock()
+		if _, err := f.resourceGroup(resourceGroup); err != nil {
+			errChan <- err
+			return
+		}
+		delete(f.ResourceGroups, strings.ToLower(resourceGroup))
+		resultChan <- autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+		errChan <- nil
+	}()
+	return resultChan, errChan
+}
+
+// ListResources returns the VMs, NICs, managed disks, storage accounts and other resources of the resource group
+func (f *FakeACSEngineClient) ListResources(resourceGroup string) (resources.ListResult, error) {
+	if err := f.call("ListResources", resourceGroup); err != nil {
+		return resources.ListResult{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return resources.ListResult{}, err
+	}
+	genericResources := []resources.GenericResource{}
+	add := func(resourceType, name string, tags *map[string]*string) {
+		genericResources = append(genericResources, resources.GenericResource{
+			ID:   to.StringPtr(fakeResourceID(rg.Name, resourceType, name)),
+			Name: to.StringPtr(name),
+			Type: to.StringPtr(resourceType),
+			Tags: tags,
+		})
+	}
+	for _, vm := range rg.VirtualMachines {
+		add("Microsoft.Compute/virtualMachines", to.String(vm.Name), vm.Tags)
+	}
+	for name := range rg.NetworkInterfaces {
+		add("Microsoft.Network/networkInterfaces", name, nil)
+	}
+	for name := range rg.ManagedDisks {
+		add("Microsoft.Compute/disks", name, nil)
+	}
+	for _, account := range rg.StorageAccounts {
+		add("Microsoft.Storage/storageAccounts", account.AccountName, nil)
+	}
+	for name, resourceType := range rg.OtherResources {
+		add(resourceType, name, nil)
+	}
+	return resources.ListResult{Value: &genericResources}, nil
+}
+
+// DeleteResource deletes the resource, which must not be in use if it is a NIC or a managed disk
+func (f *FakeACSEngineClient) DeleteResource(ctx context.Context, resourceID string) (<-chan autorest.Response, <-chan error) {
+	resultChan := make(chan autorest.Response, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(resultChan)
+		defer close(errChan)
+		if err := f.callWithContext(ctx, "DeleteResource", resourceID); err != nil {
+			errChan <- err
+			return
+		}
+
+		f.Lock()
+		defer f.Unlock()
+		if err := f.deleteResource(resourceID); err != nil {
+			errChan <- err
+			return
+		}
+		resultChan <- autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+		errChan <- nil
+	}()
+	return resultChan, errChan
+}
+
+func (f *FakeACSEngineClient) deleteResource(resourceID string) error {
+	resourceGroup, err := ResourceGroupName(resourceID)
+	if err != nil {
+		return err
+	}
+	namespace, resourceType, err := ResourceType(resourceID)
+	if err != nil {
+		return err
+	}
+	name, err := ResourceName(resourceID)
+	if err != nil {
+		return err
+	}
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return err
+	}
+
+	key := strings.ToLower(name)
+	var names map[string]bool
+	switch fullType := namespace + "/" + resourceType; fullType {
+	case "Microsoft.Compute/virtualMachines":
+		if _, ok := rg.VirtualMachines[key]; !ok {
+			return notFound("virtual machine", name)
+		}
+		delete(rg.VirtualMachines, key)
+		return nil
+	case "Microsoft.Storage/storageAccounts":
+		if _, ok := rg.StorageAccounts[key]; !ok {
+			return notFound("storage account", name)
+		}
+		delete(rg.StorageAccounts, key)
+		return nil
+	case "Microsoft.Network/networkInterfaces":
+		if vmName := f.attachedTo(func(vm compute.VirtualMachine) bool { return vmUsesNetworkInterface(vm, name) }); vmName != "" {
+			return fmt.Errorf("network interface %s is attached to virtual machine %s", name, vmName)
+		}
+		names = rg.NetworkInterfaces
+	case "Microsoft.Compute/disks":
+		if vmName := f.attachedTo(func(vm compute.VirtualMachine) bool { return vmUsesManagedDisk(vm, name) }); vmName != "" {
+			return fmt.Errorf("disk %s is attached to virtual machine %s", name, vmName)
+		}
+		names = rg.ManagedDisks
+	default:
+		if !strings.EqualFold(rg.OtherResources[key], fullType) {
+			return notFound(fullType, name)
+		}
+		delete(rg.OtherResources, key)
+		return nil
+	}
+
+	if !names[key] {
+		return notFound(resourceType, name)
+	}
+	delete(names, key)
+	return nil
+}
+
+// ListVirtualMachines returns the VMs of the resource group
+func (f *FakeACSEngineClient) ListVirtualMachines(resourceGroup string) (compute.VirtualMachineListResult, error) {
+	if err := f.call("ListVirtualMachines", resourceGroup); err != nil {
+		return compute.VirtualMachineListResult{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return compute.VirtualMachineListResult{}, err
+	}
+	vms := []compute.VirtualMachine{}
+	for _, vm := range rg.VirtualMachines {
+		vms = append(vms, vm)
+	}
+	return compute.VirtualMachineListResult{Value: &vms}, nil
+}
+
+// GetVirtualMachine returns the specified VM
+func (f *FakeACSEngineClient) GetVirtualMachine(resourceGroup, name string) (compute.VirtualMachine, error) {
+	if err := f.call("GetVirtualMachine", resourceGroup, name); err != nil {
+		return compute.VirtualMachine{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return compute.VirtualMachine{}, err
+	}
+	vm, ok := rg.VirtualMachines[strings.ToLower(name)]
+	if !ok {
+		return compute.VirtualMachine{}, notFound("virtual machine", name)
+	}
+	return vm, nil
+}
+
+// GetVirtualMachineInstanceView returns the instance view of the VM
+func (f *FakeACSEngineClient) GetVirtualMachineInstanceView(resourceGroup, name string) (compute.VirtualMachineInstanceView, error) {
+	vm, err := f.GetVirtualMachine(resourceGroup, name)
+	if err != nil {
+		return compute.VirtualMachineInstanceView{}, err
+	}
+	if vm.VirtualMachineProperties == nil || vm.InstanceView == nil {
+		return compute.VirtualMachineInstanceView{}, nil
+	}
+	return *vm.InstanceView, nil
+}
+
+// ListVirtualMachineSizes returns the VirtualMachineSizes of the location
+func (f *FakeACSEngineClient) ListVirtualMachineSizes(location string) (compute.VirtualMachineSizeListResult, error) {
+	if err := f.call("ListVirtualMachineSizes", location); err != nil {
+		return compute.VirtualMachineSizeListResult{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	sizes := append([]compute.VirtualMachineSize{}, f.VirtualMachineSizes[location]...)
+	return compute.VirtualMachineSizeListResult{Value: &sizes}, nil
+}
+
+// ListUsages returns the Usages of the location
+func (f *FakeACSEngineClient) ListUsages(location string) (compute.ListUsagesResult, error) {
+	if err := f.call("ListUsages", location); err != nil {
+		return compute.ListUsagesResult{}, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	usages := append([]compute.Usage{}, f.Usages[location]...)
+	return compute.ListUsagesResult{Value: &usages}, nil
+}
+
+// DeleteVirtualMachine deletes the specified VM, but not its NICs and disks
+func (f *FakeACSEngineClient) DeleteVirtualMachine(ctx context.Context, resourceGroup, name string) (<-chan compute.OperationStatusResponse, <-chan error) {
+	resultChan := make(chan compute.OperationStatusResponse, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(resultChan)
+		defer close(errChan)
+		if err := f.callWithContext(ctx, "DeleteVirtualMachine", resourceGroup, name); err != nil {
+			errChan <- err
+			return
+		}
+
+		f.Lock()
+		defer f.Unlock()
+		rg, err := f.resourceGroup(resourceGroup)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		if _, ok := rg.VirtualMachines[strings.ToLower(name)]; !ok {
+			errChan <- notFound("virtual machine", name)
+			return
+		}
+		delete(rg.VirtualMachines, strings.ToLower(name))
+		resultChan <- compute.OperationStatusResponse{Status: to.StringPtr("Succeeded")}
+		errChan <- nil
+	}()
+	return resultChan, errChan
+}
+
+// DeleteManagedDisk deletes the specified managed disk, which must not be attached to a VM
+func (f *FakeACSEngineClient) DeleteManagedDisk(ctx context.Context, resourceGroup, diskName string) (<-chan disk.OperationStatusResponse, <-chan error) {
+	resultChan := make(chan disk.OperationStatusResponse, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(resultChan)
+		defer close(errChan)
+		if err := f.callWithContext(ctx, "DeleteManagedDisk", resourceGroup, diskName); err != nil {
+			errChan <- err
+			return
+		}
+
+		f.Lock()
+		defer f.Unlock()
+		rg, err := f.resourceGroup(resourceGroup)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		if !rg.ManagedDisks[strings.ToLower(diskName)] {
+			errChan <- notFound("managed disk", diskName)
+			return
+		}
+		if vmName := f.attachedTo(func(vm compute.VirtualMachine) bool { return vmUsesManagedDisk(vm, diskName) }); vmName != "" {
+			errChan <- fmt.Errorf("managed disk %s is attached to virtual machine %s", diskName, vmName)
+			return
+		}
+		delete(rg.ManagedDisks, strings.ToLower(diskName))
+		resultChan <- disk.OperationStatusResponse{Status: to.StringPtr("Succeeded")}
+		errChan <- nil
+	}()
+	return resultChan, errChan
+}
+
+// GetStorageClient returns the client of the specified storage account, which is created if needed
+func (f *FakeACSEngineClient) GetStorageClient(resourceGroup, accountName string) (ACSStorageClient, error) {
+	if err := f.call("GetStorageClient", resourceGroup, accountName); err != nil {
+		return nil, err
+	}
+
+	f.Lock()
+	defer f.Unlock()
+
+	rg, err := f.resourceGroup(resourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	return f.storageAccount(rg, accountName), nil
+}
+
+// DeleteNetworkInterface deletes the specified NIC, which must not be attached to a VM
+func (f *FakeACSEngineClient) DeleteNetworkInterface(ctx context.Context, resourceGroup, nicName string) (<-chan autorest.Response, <-chan error) {
+	resultChan := make(chan autorest.Response, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		defer close(resultChan)
+		defer close(errChan)
+		if err := f.callWithContext(ctx, "DeleteNetworkInterface", resourceGroup, nicName); err != nil {
+			errChan <- err
+			return
+		}
+
+		f.Lock()
+		defer f.Unlock()
+		rg, err := f.resourceGroup(resourceGroup)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		if !rg.NetworkInterfaces[strings.ToLower(nicName)] {
+			errChan <- notFound("network interface", nicName)
+			return
+		}
+		if vmName := f.attachedTo(func(vm compute.VirtualMachine) bool { return vmUsesNetworkInterface(vm, nicName) }); vmName != "" {
+			errChan <- fmt.Errorf("network interface %s is attached to virtual machine %s", nicName, vmName)
+			return
+		}
+		delete(rg.NetworkInterfaces, strings.ToLower(nicName))
+		resultChan <- autorest.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+		errChan <- nil
+	}()
+	return resultChan, errChan
+}
+
+// DeleteBlob deletes the specified blob
+func (s *FakeStorageClient) DeleteBlob(container, blob string) error {
+	if err := s.client.call("DeleteBlob", s.AccountName, container, blob); err != nil {
+		return err
+	}
+
+	s.client.Lock()
+	defer s.client.Unlock()
+
+	key := container + "/" + blob
+	if !s.Blobs[key] {
+		return notFound("blob", key)
+	}
+	delete(s.Blobs, key)
+	return nil
+}
+
+// call records the call, waits for the latency, and returns the failure injected for the method, if any
+func (f *FakeACSEngineClient) call(method string, args ...string) error {
+	return f.callWithContext(context.Background(), method, args...)
+}
+
+// callWithContext records a call like call, and fails it with the error of the context once the context is done
+func (f *FakeACSEngineClient) callWithContext(ctx context.Context, method string, args ...string) error {
+	f.Lock()
+	f.Calls = append(f.Calls, FakeCall{Method: method, Args: args})
+	latency := f.Latency
+	err := f.Failures[method]
+	f.Unlock()
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
+
+func (f *FakeACSEngineClient) addResourceGroup(name, location string) *FakeResourceGroup {
+	rg, ok := f.ResourceGroups[strings.ToLower(name)]
+	if !ok {
+		rg = &FakeResourceGroup{
+			Name:              name,
+			Location:          location,
+			VirtualMachines:   map[string]compute.VirtualMachine{},
+			NetworkInterfaces: map[string]bool{},
+			ManagedDisks:      map[string]bool{},
+			StorageAccounts:   map[string]*FakeStorageClient{},
+			Deployments:       []*FakeDeployment{},
+			OtherResources:    map[string]string{},
+		}
+		f.ResourceGroups[strings.ToLower(name)] = rg
+	}
+	return rg
+}
+
+func (f *FakeACSEngineClient) resourceGroup(name string) (*FakeResourceGroup, error) {
+	rg, ok := f.ResourceGroups[strings.ToLower(name)]
+	if !ok {
+		return nil, notFound("resource group", name)
+	}
+	return rg, nil
+}
+
+func (f *FakeACSEngineClient) storageAccount(rg *FakeResourceGroup, accountName string) *FakeStorageClient {
+	account, ok := rg.StorageAccounts[strings.ToLower(accountName)]
+	if !ok {
+		account = &FakeStorageClient{AccountName: accountName, Blobs: map[string]bool{}, client: f}
+		rg.StorageAccounts[strings.ToLower(accountName)] = account
+	}
+	return account
+}
+
+func (f *FakeACSEngineClient) addDisk(rg *FakeResourceGroup, vhd *compute.VirtualHardDisk, managedDisk *compute.ManagedDiskParameters) {
+	if managedDisk != nil {
+		if name, err := ResourceName(to.String(managedDisk.ID)); err == nil {
+			diskRG := rg
+			if diskRGName, err := ResourceGroupName(*managedDisk.ID); err == nil {
+				diskRG = f.addResourceGroup(diskRGName, rg.Location)
+			}
+			diskRG.ManagedDisks[strings.ToLower(name)] = true
+		}
+	}
+	if vhd != nil && vhd.URI != nil {
+		if accountName, container, blob, err := SplitBlobURI(*vhd.URI); err == nil {
+			f.storageAccount(rg, accountName).Blobs[container+"/"+blob] = true
+		}
+	}
+}
+
+// attachedTo returns the name of a VM, in any resource group, for which uses returns true
+func (f *FakeACSEngineClient) attachedTo(uses func(vm compute.VirtualMachine) bool) string {
+	for _, rg := range f.ResourceGroups {
+		for _, vm := range rg.VirtualMachines {
+			if uses(vm) {
+				return to.String(vm.Name)
+			}
+		}
+	}
+	return ""
+}
+
+func vmUsesManagedDisk(vm compute.VirtualMachine, diskName string) bool {
+	if vm.VirtualMachineProperties == nil || vm.StorageProfile == nil {
+		return false
+	}
+	disks := []*compute.ManagedDiskParameters{}
+	if vm.StorageProfile.OsDisk != nil {
+		disks = append(disks, vm.StorageProfile.OsDisk.ManagedDisk)
+	}
+	if vm.StorageProfile.DataDisks != nil {
+		for _, dataDisk := range *vm.StorageProfile.DataDisks {
+			disks = append(disks, dataDisk.ManagedDisk)
+		}
+	}
+	for _, managedDisk := range disks {
+		if managedDisk == nil {
+			continue
+		}
+		if name, err := ResourceName(to.String(managedDisk.ID)); err == nil && strings.EqualFold(name, diskName) {
+			return true
+		}
+	}
+	return false
+}
+
+func vmUsesNetworkInterface(vm compute.VirtualMachine, nicName string) bool {
+	if vm.VirtualMachineProperties == nil || vm.NetworkProfile == nil || vm.NetworkProfile.NetworkInterfaces == nil {
+		return false
+	}
+	for _, nic := range *vm.NetworkProfile.NetworkInterfaces {
+		if name, err := ResourceName(to.String(nic.ID)); err == nil && strings.EqualFold(name, nicName) {
+			return true
+		}
+	}
+	return false
+}
+
+func fakeGroup(rg *FakeResourceGroup) *resources.Group {
+	tags := rg.Tags
+	return &resources.Group{
+		ID:       to.StringPtr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/" + rg.Name),
+		Name:     to.StringPtr(rg.Name),
+		Location: to.StringPtr(rg.Location),
+		Tags:     &tags,
+	}
+}
+
+func fakeResourceID(resourceGroup, resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/%s/providers/%s/%s", resourceGroup, resourceType, name)
+}
+
+// notFound returns the error ARM returns for a missing resource
+func notFound(resourceType, name string) error {
+	return autorest.DetailedError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("the %s %s was not found", resourceType, name),
+	}
+}
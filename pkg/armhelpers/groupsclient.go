@@ -1,16 +1,32 @@
 package armhelpers
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
-	"github.com/prometheus/common/log"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
 )
 
 // EnsureResourceGroup ensures the named resouce group exists in the given location.
-func (az *AzureClient) EnsureResourceGroup(name, location string) (resourceGroup *resources.Group, err error) {
-	log.Debugf("Ensuring resource group exists. resourcegroup=%q", name)
+// The tags are only set when the resource group is created.
+func (az *AzureClient) EnsureResourceGroup(name, location string, tags map[string]*string) (resourceGroup *resources.Group, err error) {
+	az.logger.Debugf("Ensuring resource group exists. resourcegroup=%q", name)
+	existing, err := az.groupsClient.Get(name)
+	if err == nil {
+		return &existing, nil
+	}
+	if existing.StatusCode != http.StatusNotFound {
+		return &existing, err
+	}
+
 	response, err := az.groupsClient.CreateOrUpdate(name, resources.Group{
 		Name:     &name,
 		Location: &location,
+		Tags:     &tags,
 	})
 	if err != nil {
 		return &response, err
@@ -18,3 +34,99 @@ func (az *AzureClient) EnsureResourceGroup(name, location string) (resourceGroup
 
 	return &response, nil
 }
+
+// GetResourceGroup retrieves the specified resource group.
+func (az *AzureClient) GetResourceGroup(name string) (resources.Group, error) {
+	return az.groupsClient.Get(name)
+}
+
+// DeleteResourceGroup deletes the specified resource group and all its resources.
+func (az *AzureClient) DeleteResourceGroup(ctx context.Context, name string) (<-chan autorest.Response, <-chan error) {
+	return az.groupsClient.Delete(name, ctx.Done())
+}
+
+// ListResources returns all the resources of the resource group, following the result pages
+func (az *AzureClient) ListResources(resourceGroup string) (resources.ListResult, error) {
+	page, err := az.groupsClient.ListResources(resourceGroup, "", "", nil)
+	if err != nil {
+		return page, err
+	}
+
+	genericResources := []resources.GenericResource{}
+	for {
+		if page.Value != nil {
+			genericResources = append(genericResources, *page.Value...)
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			return resources.ListResult{Value: &genericResources}, nil
+		}
+		if page, err = az.groupsClient.ListResourcesNextResults(page); err != nil {
+			return page, err
+		}
+	}
+}
+
+// DeleteResource deletes the resource with the specified identifier, whatever its type.
+// The request uses the latest stable API version of the resource type.
+func (az *AzureClient) DeleteResource(ctx context.Context, resourceID string) (<-chan autorest.Response, <-chan error) {
+	resultChan := make(chan autorest.Response, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		var err error
+		var result autorest.Response
+		defer func() {
+			resultChan <- result
+			errChan <- err
+			close(resultChan)
+			close(errChan)
+		}()
+
+		apiVersion, err := az.resourceAPIVersion(resourceID)
+		if err != nil {
+			return
+		}
+		req, err := az.resourcesClient.DeleteByIDPreparer(resourceID, ctx.Done())
+		if err != nil {
+			return
+		}
+		// the generic resources API version is not supported by the resource providers
+		query := req.URL.Query()
+		query.Set("api-version", apiVersion)
+		req.URL.RawQuery = query.Encode()
+
+		resp, err := az.resourcesClient.DeleteByIDSender(req)
+		if err != nil {
+			result.Response = resp
+			return
+		}
+		result, err = az.resourcesClient.DeleteByIDResponder(resp)
+	}()
+	return resultChan, errChan
+}
+
+// resourceAPIVersion returns the latest stable API version of the type of the resource
+func (az *AzureClient) resourceAPIVersion(resourceID string) (string, error) {
+	namespace, resourceType, err := ResourceType(resourceID)
+	if err != nil {
+		return "", err
+	}
+	provider, err := az.providersClient.Get(namespace, "")
+	if err != nil {
+		return "", err
+	}
+
+	if provider.ResourceTypes != nil {
+		for _, providerType := range *provider.ResourceTypes {
+			if !strings.EqualFold(to.String(providerType.ResourceType), resourceType) || providerType.APIVersions == nil {
+				continue
+			}
+			// the API versions are listed from the latest
+			for _, apiVersion := range *providerType.APIVersions {
+				if !strings.Contains(apiVersion, "preview") {
+					return apiVersion, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("no API version found for resource type %s/%s", namespace, resourceType)
+}
@@ -1,35 +1,72 @@
 package armhelpers
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/azure-sdk-for-go/arm/disk"
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/Azure/go-autorest/autorest"
 )
 
 // ACSEngineClient is the interface used to talk to an Azure environment.
 // This interface exposes just the subset of Azure APIs and clients needed for
-// ACS-Engine.
+// ACS-Engine. The long-running operations stop waiting for ARM when their context is done.
 type ACSEngineClient interface {
 	//
 	// RESOURCES
 
-	// DeployTemplate can deploy a template into Azure ARM
-	DeployTemplate(resourceGroup, name string, template, parameters map[string]interface{}, cancel <-chan struct{}) (*resources.DeploymentExtended, error)
+	// DeployTemplate can deploy a template into Azure ARM. The deployment is canceled when the context is done.
+	DeployTemplate(ctx context.Context, resourceGroup, name string, template, parameters map[string]interface{}) (*resources.DeploymentExtended, error)
+
+	// ValidateTemplate asks ARM to validate a template and its parameters without deploying them
+	ValidateTemplate(resourceGroup, name string, template, parameters map[string]interface{}) (resources.DeploymentValidateResult, error)
+
+	// ListProviders lists the resource providers and the registration state of the subscription to each of them
+	ListProviders() (resources.ProviderListResult, error)
+
+	// RegisterProvider registers the subscription to the specified resource provider
+	RegisterProvider(namespace string) (resources.Provider, error)
+
+	// EnsureResourceGroup ensures the specified resource group exists in the specified location,
+	// creating it with the specified tags if needed
+	EnsureResourceGroup(resourceGroup, location string, tags map[string]*string) (*resources.Group, error)
+
+	// GetResourceGroup retrieves the specified resource group
+	GetResourceGroup(resourceGroup string) (resources.Group, error)
 
-	// EnsureResourceGroup ensures the specified resource group exists in the specified location
-	EnsureResourceGroup(resourceGroup, location string) (*resources.Group, error)
+	// DeleteResourceGroup deletes the specified resource group and all its resources
+	DeleteResourceGroup(ctx context.Context, resourceGroup string) (<-chan autorest.Response, <-chan error)
+
+	// ListResources lists the resources of the specified resource group
+	ListResources(resourceGroup string) (resources.ListResult, error)
+
+	// DeleteResource deletes the resource with the specified identifier
+	DeleteResource(ctx context.Context, resourceID string) (<-chan autorest.Response, <-chan error)
 
 	//
 	// COMPUTE
 
-	// List lists VM resources
+	// ListVirtualMachines lists all the VMs of the resource group, across the result pages
 	ListVirtualMachines(resourceGroup string) (compute.VirtualMachineListResult, error)
 
 	// GetVirtualMachine retrieves the specified virtual machine.
 	GetVirtualMachine(resourceGroup, name string) (compute.VirtualMachine, error)
 
+	// GetVirtualMachineInstanceView retrieves the runtime state of the specified virtual machine.
+	GetVirtualMachineInstanceView(resourceGroup, name string) (compute.VirtualMachineInstanceView, error)
+
 	// DeleteVirtualMachine deletes the specified virtual machine.
-	DeleteVirtualMachine(resourceGroup, name string, cancel <-chan struct{}) (<-chan compute.OperationStatusResponse, <-chan error)
+	DeleteVirtualMachine(ctx context.Context, resourceGroup, name string) (<-chan compute.OperationStatusResponse, <-chan error)
+
+	// ListVirtualMachineSizes lists the VM sizes available in the specified location.
+	ListVirtualMachineSizes(location string) (compute.VirtualMachineSizeListResult, error)
+
+	// ListUsages lists the compute resource usages and limits of the subscription in the specified location.
+	ListUsages(location string) (compute.ListUsagesResult, error)
+
+	// DeleteManagedDisk deletes the specified managed disk.
+	DeleteManagedDisk(ctx context.Context, resourceGroup, diskName string) (<-chan disk.OperationStatusResponse, <-chan error)
 
 	//
 	// STORAGE
@@ -42,7 +79,7 @@ type ACSEngineClient interface {
 	// NETWORK
 
 	// DeleteNetworkInterface deletes the specified network interface.
-	DeleteNetworkInterface(resourceGroup, nicName string, cancel <-chan struct{}) (<-chan autorest.Response, <-chan error)
+	DeleteNetworkInterface(ctx context.Context, resourceGroup, nicName string) (<-chan autorest.Response, <-chan error)
 }
 
 // ACSStorageClient interface models the azure storage client
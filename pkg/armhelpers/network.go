@@ -1,10 +1,12 @@
 package armhelpers
 
 import (
+	"context"
+
 	"github.com/Azure/go-autorest/autorest"
 )
 
 // DeleteNetworkInterface deletes the specified network interface.
-func (az *AzureClient) DeleteNetworkInterface(resourceGroup, nicName string, cancel <-chan struct{}) (<-chan autorest.Response, <-chan error) {
-	return az.interfacesClient.Delete(resourceGroup, nicName, cancel)
+func (az *AzureClient) DeleteNetworkInterface(ctx context.Context, resourceGroup, nicName string) (<-chan autorest.Response, <-chan error) {
+	return az.interfacesClient.Delete(resourceGroup, nicName, ctx.Done())
 }
@@ -0,0 +1,120 @@
+package armhelpers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/Sirupsen/logrus"
+)
+
+const (
+	// DefaultProviderRegistrationTimeout is how long the registration of the resource providers can take
+	DefaultProviderRegistrationTimeout = 10 * time.Minute
+
+	registeredState = "Registered"
+)
+
+// ProviderRegistrationPollInterval is how often the registration state of the resource providers is polled
+var ProviderRegistrationPollInterval = 10 * time.Second
+
+// ListProviders returns all the resource providers of the subscription, following the result pages
+func (az *AzureClient) ListProviders() (resources.ProviderListResult, error) {
+	page, err := az.providersClient.List(nil, "")
+	if err != nil {
+		return page, err
+	}
+
+	providers := []resources.Provider{}
+	for {
+		if page.Value != nil {
+			providers = append(providers, *page.Value...)
+		}
+		if page.NextLink == nil || *page.NextLink == "" {
+			return resources.ProviderListResult{Value: &providers}, nil
+		}
+		if page, err = az.providersClient.ListNextResults(page); err != nil {
+			return page, err
+		}
+	}
+}
+
+// RegisterProvider registers the subscription to the resource provider
+func (az *AzureClient) RegisterProvider(namespace string) (resources.Provider, error) {
+	return az.providersClient.Register(namespace)
+}
+
+// EnsureResourceProvidersRegistered registers the subscription to the resource providers it is not
+// registered to yet, and waits up to timeout for their registration to complete, or until the context is done
+func EnsureResourceProvidersRegistered(ctx context.Context, logger *logrus.Entry, client ACSEngineClient, namespaces []string, timeout time.Duration) error {
+	states, err := providerStates(client)
+	if err != nil {
+		return err
+	}
+
+	pending := []string{}
+	for _, namespace := range namespaces {
+		state, ok := states[strings.ToLower(namespace)]
+		if !ok {
+			return fmt.Errorf("Unknown resource provider %q", namespace)
+		}
+		if state == registeredState {
+			logger.Debugf("Already registered for %q", namespace)
+			continue
+		}
+		logger.Infof("Registering subscription to resource provider. provider=%q state=%q", namespace, state)
+		if _, err := client.RegisterProvider(namespace); err != nil {
+			return fmt.Errorf("error registering resource provider %q: %s", namespace, err.Error())
+		}
+		pending = append(pending, namespace)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	logger.Infof("Waiting up to %s for the registration of %s", timeout, strings.Join(pending, ", "))
+	deadline := time.Now().Add(timeout)
+	for {
+		states, err := providerStates(client)
+		if err != nil {
+			return err
+		}
+		unregistered := []string{}
+		for _, namespace := range pending {
+			if state := states[strings.ToLower(namespace)]; state != registeredState {
+				unregistered = append(unregistered, fmt.Sprintf("%s (%s)", namespace, state))
+			}
+		}
+		if len(unregistered) == 0 {
+			logger.Infof("Registered resource providers %s", strings.Join(pending, ", "))
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for the registration of resource providers %s", timeout, strings.Join(unregistered, ", "))
+		}
+		select {
+		case <-time.After(ProviderRegistrationPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("canceled while waiting for the registration of resource providers %s: %s", strings.Join(unregistered, ", "), ctx.Err().Error())
+		}
+	}
+}
+
+// providerStates returns the registration state of each resource provider, indexed by lower case namespace
+func providerStates(client ACSEngineClient) (map[string]string, error) {
+	providers, err := client.ListProviders()
+	if err != nil {
+		return nil, fmt.Errorf("error listing the resource providers: %s", err.Error())
+	}
+
+	states := map[string]string{}
+	if providers.Value != nil {
+		for _, provider := range *providers.Value {
+			states[strings.ToLower(to.String(provider.Namespace))] = to.String(provider.RegistrationState)
+		}
+	}
+	return states, nil
+}
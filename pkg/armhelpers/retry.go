@@ -0,0 +1,183 @@
+package armhelpers
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/cookiejar"
+	"strconv"
+	"time"
+
+	azStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Sirupsen/logrus"
+)
+
+// RetryPolicy controls how the AzureClient retries the ARM and storage requests that fail
+// with a transient error: a network error, a throttling (429) or a server error (5xx).
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, 1 disables retries
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled for each following retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, unless ARM asks for a longer one with Retry-After
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the retry policy of the AzureClient unless SetRetryPolicy is called
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    6,
+	InitialBackoff: 2 * time.Second,
+	MaxBackoff:     time.Minute,
+}
+
+var retryableStatusCodes = []int{
+	http.StatusRequestTimeout,      // 408
+	http.StatusTooManyRequests,     // 429
+	http.StatusInternalServerError, // 500
+	http.StatusBadGateway,          // 502
+	http.StatusServiceUnavailable,  // 503
+	http.StatusGatewayTimeout,      // 504
+}
+
+// SetRetryPolicy applies the retry policy to every request the client sends, including the
+// polling of long running operations and the requests of the storage clients it returns
+func (az *AzureClient) SetRetryPolicy(policy RetryPolicy) {
+	az.retryPolicy = policy
+
+	jar, _ := cookiejar.New(nil)
+	sender := &retrySender{policy: policy, logger: az.logger, sender: &http.Client{Jar: jar}}
+	for _, client := range []*autorest.Client{
+		&az.deploymentsClient.Client,
+		&az.deploymentOperationsClient.Client,
+		&az.resourcesClient.Client,
+		&az.storageAccountsClient.Client,
+		&az.interfacesClient.Client,
+		&az.groupsClient.Client,
+		&az.providersClient.Client,
+		&az.subscriptionsClient.Client,
+		&az.virtualMachinesClient.Client,
+		&az.disksClient.Client,
+		&az.virtualMachineSizesClient.Client,
+		&az.usageClient.Client,
+	} {
+		// the policy replaces the fixed retries autorest does on its own
+		client.RetryAttempts = 0
+		client.Sender = sender
+	}
+}
+
+// retrySender implements the autorest.Sender interface, retrying the requests as the policy specifies
+type retrySender struct {
+	policy RetryPolicy
+	logger *logrus.Entry
+	sender autorest.Sender
+}
+
+func (s *retrySender) Do(req *http.Request) (*http.Response, error) {
+	return s.policy.send(s.logger, s.sender.Do, req)
+}
+
+// retryStorageSender implements the storage Sender interface, retrying the requests as the policy specifies
+type retryStorageSender struct {
+	policy RetryPolicy
+	logger *logrus.Entry
+}
+
+func (s *retryStorageSender) Send(c *azStorage.Client, req *http.Request) (*http.Response, error) {
+	return s.policy.send(s.logger, c.HTTPClient.Do, req)
+}
+
+// send sends the request until it succeeds, fails with an error that is not transient, or
+// MaxAttempts is reached. The last response or error is returned.
+func (p RetryPolicy) send(logger *logrus.Entry, do func(*http.Request) (*http.Response, error), req *http.Request) (*http.Response, error) {
+	// the body must be sent again with each attempt
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	for attempt := 1; ; attempt++ {
+		if req.Body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := do(req)
+		if attempt >= p.MaxAttempts || !isRetryable(resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt, resp)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			// the response is discarded, release its connection
+			ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+		logger.Warnf("%s %s failed (%s), retrying in %s (attempt %d of %d)", req.Method, req.URL.Path, reason, delay, attempt+1, p.MaxAttempts)
+
+		select {
+		case <-time.After(delay):
+		case <-req.Cancel:
+			return nil, fmt.Errorf("%s %s canceled while waiting to retry", req.Method, req.URL.Path)
+		}
+	}
+}
+
+// backoff returns how long to wait before sending the request again: the Retry-After delay
+// of the response if any, otherwise an exponential backoff with jitter
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+	}
+
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// jitter spreads the retries of concurrent operations throttled at the same time
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return autorest.ResponseHasStatusCode(resp, retryableStatusCodes...)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number of seconds or an HTTP date
+func parseRetryAfter(retryAfter string) (time.Duration, bool) {
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		delay := date.Sub(time.Now())
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
@@ -0,0 +1,105 @@
+package armhelpers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+)
+
+func Test_RetryPolicy(t *testing.T) {
+	attempts := 0
+	bodies := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		switch attempts {
+		case 1:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	req, _ := http.NewRequest("PUT", server.URL, strings.NewReader("template"))
+	resp, err := policy.send(logrus.NewEntry(logrus.StandardLogger()), http.DefaultClient.Do, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("incorrect status code. expected=%d actual=%d", http.StatusOK, resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("incorrect number of attempts. expected=3 actual=%d", attempts)
+	}
+	for _, body := range bodies {
+		if body != "template" {
+			t.Fatalf("expected the request body to be sent with each attempt, got %q", body)
+		}
+	}
+}
+
+func Test_RetryPolicyMaxAttempts(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	resp, err := policy.send(logrus.NewEntry(logrus.StandardLogger()), http.DefaultClient.Do, req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected the last response to be returned, got status %d", resp.StatusCode)
+	}
+	if attempts != 3 {
+		t.Fatalf("incorrect number of attempts. expected=3 actual=%d", attempts)
+	}
+}
+
+func Test_RetryPolicyNotRetryable(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	if _, err := policy.send(logrus.NewEntry(logrus.StandardLogger()), http.DefaultClient.Do, req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected a bad request not to be retried, attempts=%d", attempts)
+	}
+}
+
+func Test_RetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 2 * time.Second, MaxBackoff: 10 * time.Second}
+	for attempt, expected := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		backoff := policy.backoff(attempt+1, nil)
+		if backoff < expected/2 || backoff > expected {
+			t.Fatalf("incorrect backoff for attempt %d. expected between %s and %s, actual=%s", attempt+1, expected/2, expected, backoff)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"30"}}}
+	if backoff := policy.backoff(1, resp); backoff != 30*time.Second {
+		t.Fatalf("expected Retry-After to be honored. expected=%s actual=%s", 30*time.Second, backoff)
+	}
+}
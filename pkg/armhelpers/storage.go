@@ -23,6 +23,8 @@ func (az *AzureClient) GetStorageClient(resourceGroup, accountName string) (ACSS
 		return nil, err
 	}
 
+	client.Sender = &retryStorageSender{policy: az.retryPolicy, logger: az.logger}
+
 	return &AzureStorageClient{
 		client: &client,
 	}, nil
@@ -3,9 +3,12 @@ package armhelpers
 import (
 	"fmt"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
 )
 
 // ResourceName returns the last segment (the resource name) for the specified resource identifier.
@@ -19,6 +22,40 @@ func ResourceName(ID string) (string, error) {
 	return name, nil
 }
 
+// ResourceGroupName returns the resource group segment of the specified resource identifier.
+func ResourceGroupName(ID string) (string, error) {
+	parts := strings.Split(ID, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "resourceGroups") && len(parts[i+1]) > 0 {
+			return parts[i+1], nil
+		}
+	}
+
+	return "", fmt.Errorf("resource group was missing from identifier")
+}
+
+// ResourceType returns the provider namespace and the resource type of the specified resource identifier,
+// such as Microsoft.Network and virtualNetworks/subnets.
+func ResourceType(ID string) (string, string, error) {
+	parts := strings.Split(strings.Trim(ID, "/"), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if !strings.EqualFold(parts[i], "providers") {
+			continue
+		}
+		// the segments after the namespace alternate between the types and the names
+		types := []string{}
+		for j := i + 2; j < len(parts)-1; j += 2 {
+			types = append(types, parts[j])
+		}
+		if len(types) == 0 {
+			break
+		}
+		return parts[i+1], strings.Join(types, "/"), nil
+	}
+
+	return "", "", fmt.Errorf("resource type was missing from identifier")
+}
+
 // SplitBlobURI returns a decomposed blob URI parts: accountName, containerName, blobName.
 func SplitBlobURI(URI string) (string, string, string, error) {
 	uri, err := url.Parse(URI)
@@ -35,18 +72,105 @@ func SplitBlobURI(URI string) (string, string, string, error) {
 	return accountName, containerName, blobPath, nil
 }
 
+const (
+	// k8sWindowsPoolIndexBase is added to the agent pool index in the names of the Windows VMs
+	k8sWindowsPoolIndexBase = 900
+)
+
+var (
+	// k8sLinuxVMNameRegexp matches the names of the Linux VMs of a Kubernetes cluster, k8s-<pool name>-<cluster ID>-<index>,
+	// where the pool name of the masters is master
+	k8sLinuxVMNameRegexp = regexp.MustCompile(`^(?i:k8s)-(.+)-(\d{8})-(\d+)$`)
+	// k8sWindowsVMNameRegexp matches the names of the Windows VMs of a Kubernetes cluster,
+	// <first 5 characters of the cluster ID>acs<900 + pool index><index>
+	k8sWindowsVMNameRegexp = regexp.MustCompile(`^(\d{5})(?i:acs)(9\d\d)(\d+)$`)
+	// vmssInstanceNameRegexp matches the names of the VMs of a scale set, <scale set name>_<instance ID>
+	vmssInstanceNameRegexp = regexp.MustCompile(`^(.+)_(\d+)$`)
+)
+
+// K8sLinuxVMNameParts returns the pool name, the cluster ID and the index of a Linux VM of a Kubernetes
+// cluster. The pool name of the masters is master.
+func K8sLinuxVMNameParts(vmName string) (string, string, int, error) {
+	m := k8sLinuxVMNameRegexp.FindStringSubmatch(vmName)
+	if m == nil {
+		return "", "", 0, fmt.Errorf("%s is not the name of a Linux VM of a Kubernetes cluster", vmName)
+	}
+	index, err := strconv.Atoi(m[3])
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return m[1], m[2], index, nil
+}
+
+// K8sWindowsVMNameParts returns the cluster ID prefix, the agent pool index and the index of a Windows VM
+// of a Kubernetes cluster.
+func K8sWindowsVMNameParts(vmName string) (string, int, int, error) {
+	m := k8sWindowsVMNameRegexp.FindStringSubmatch(vmName)
+	if m == nil {
+		return "", 0, 0, fmt.Errorf("%s is not the name of a Windows VM of a Kubernetes cluster", vmName)
+	}
+	poolIndex, err := strconv.Atoi(m[2])
+	if err != nil {
+		return "", 0, 0, err
+	}
+	index, err := strconv.Atoi(m[3])
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	return m[1], poolIndex - k8sWindowsPoolIndexBase, index, nil
+}
+
+// VMSSInstanceNameParts returns the scale set name and the instance ID of a VM of a scale set.
+func VMSSInstanceNameParts(vmName string) (string, int, error) {
+	m := vmssInstanceNameRegexp.FindStringSubmatch(vmName)
+	if m == nil {
+		return "", 0, fmt.Errorf("%s is not the name of a VM of a scale set", vmName)
+	}
+	instanceID, err := strconv.Atoi(m[2])
+	if err != nil {
+		return "", 0, err
+	}
+
+	return m[1], instanceID, nil
+}
+
+// GetVMNameIndex returns the index of a VM of a Kubernetes cluster, or the instance ID of a VM of a scale set.
+func GetVMNameIndex(vmName string) (int, error) {
+	if _, instanceID, err := VMSSInstanceNameParts(vmName); err == nil {
+		return instanceID, nil
+	}
+	if _, _, index, err := K8sLinuxVMNameParts(vmName); err == nil {
+		return index, nil
+	}
+	if _, _, index, err := K8sWindowsVMNameParts(vmName); err == nil {
+		return index, nil
+	}
+
+	return 0, fmt.Errorf("the index of VM %s was not found in its name", vmName)
+}
+
 // ByVMNameOffset implements sort.Interface for []VirtualMachine based on
-// the Name field.
+// the index in the Name field, compared as a number. The VMs whose names
+// have no index come last, sorted by name.
 type ByVMNameOffset []compute.VirtualMachine
 
 func (vm ByVMNameOffset) Len() int      { return len(vm) }
 func (vm ByVMNameOffset) Swap(i, j int) { vm[i], vm[j] = vm[j], vm[i] }
 func (vm ByVMNameOffset) Less(i, j int) bool {
-	vm1NameParts := strings.Split(*vm[i].Name, "-")
-	vm1Num := vm1NameParts[len(vm1NameParts)-1]
+	vm1Name, vm2Name := to.String(vm[i].Name), to.String(vm[j].Name)
+	vm1Num, err1 := GetVMNameIndex(vm1Name)
+	vm2Num, err2 := GetVMNameIndex(vm2Name)
 
-	vm2NameParts := strings.Split(*vm[j].Name, "-")
-	vm2Num := vm2NameParts[len(vm2NameParts)-1]
-
-	return vm1Num < vm2Num
+	switch {
+	case err1 == nil && err2 == nil && vm1Num != vm2Num:
+		return vm1Num < vm2Num
+	case err1 == nil && err2 != nil:
+		return true
+	case err1 != nil && err2 == nil:
+		return false
+	default:
+		return vm1Name < vm2Name
+	}
 }
@@ -1,7 +1,11 @@
 package armhelpers
 
 import (
+	"sort"
 	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
 )
 
 func Test_SplitBlobURI(t *testing.T) {
@@ -22,3 +26,85 @@ func Test_SplitBlobURI(t *testing.T) {
 		t.Fatalf("unexpected error: %s", err)
 	}
 }
+
+func Test_ResourceGroupName(t *testing.T) {
+	expectedResourceGroup := "myResourceGroup"
+	resourceGroup, err := ResourceGroupName("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myResourceGroup/providers/Microsoft.Compute/disks/k8s-agentpool1-22551669-0_OsDisk_1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resourceGroup != expectedResourceGroup {
+		t.Fatalf("incorrect resource group. expected=%s actual=%s", expectedResourceGroup, resourceGroup)
+	}
+
+	if _, err = ResourceGroupName("k8s-agentpool1-22551669-0_OsDisk_1"); err == nil {
+		t.Fatalf("expected an error for an identifier without resource group")
+	}
+}
+
+func Test_ResourceType(t *testing.T) {
+	for ID, expected := range map[string][2]string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/networkInterfaces/nic-0":          {"Microsoft.Network", "networkInterfaces"},
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vnet/subnets/sub": {"Microsoft.Network", "virtualNetworks/subnets"},
+	} {
+		namespace, resourceType, err := ResourceType(ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if namespace != expected[0] || resourceType != expected[1] {
+			t.Fatalf("incorrect resource type. expected=%s/%s actual=%s/%s", expected[0], expected[1], namespace, resourceType)
+		}
+	}
+
+	if _, _, err := ResourceType("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg"); err == nil {
+		t.Fatalf("expected an error for an identifier without a resource type")
+	}
+}
+
+func Test_K8sVMNameParts(t *testing.T) {
+	poolName, clusterID, index, err := K8sLinuxVMNameParts("k8s-agentpool1-22551669-12")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if poolName != "agentpool1" || clusterID != "22551669" || index != 12 {
+		t.Fatalf("incorrect name parts. expected=agentpool1 22551669 12 actual=%s %s %d", poolName, clusterID, index)
+	}
+
+	clusterIDPrefix, poolIndex, index, err := K8sWindowsVMNameParts("22551acs90110")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if clusterIDPrefix != "22551" || poolIndex != 1 || index != 10 {
+		t.Fatalf("incorrect name parts. expected=22551 1 10 actual=%s %d %d", clusterIDPrefix, poolIndex, index)
+	}
+
+	if _, _, _, err = K8sLinuxVMNameParts("22551acs90110"); err == nil {
+		t.Fatalf("expected an error for a Windows VM name")
+	}
+	if _, err = GetVMNameIndex("jumpbox"); err == nil {
+		t.Fatalf("expected an error for a VM name without index")
+	}
+}
+
+func Test_ByVMNameOffset(t *testing.T) {
+	names := []string{
+		"k8s-master-22551669-10",
+		"jumpbox",
+		"k8s-master-22551669-2",
+		"22551acs90011",
+		"k8s-agentpool1-22551669-vmss_9",
+		"22551acs9003",
+	}
+	vms := []compute.VirtualMachine{}
+	for _, name := range names {
+		vms = append(vms, compute.VirtualMachine{Name: to.StringPtr(name)})
+	}
+
+	sort.Sort(ByVMNameOffset(vms))
+	expected := []string{"k8s-master-22551669-2", "22551acs9003", "k8s-agentpool1-22551669-vmss_9", "k8s-master-22551669-10", "22551acs90011", "jumpbox"}
+	for i, vm := range vms {
+		if *vm.Name != expected[i] {
+			t.Fatalf("incorrect order at %d. expected=%s actual=%s", i, expected[i], *vm.Name)
+		}
+	}
+}
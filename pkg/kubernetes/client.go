@@ -0,0 +1,363 @@
+// Package kubernetes is a client of the Kubernetes API server of the clusters, used to drain and validate their nodes
+package kubernetes
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// NodeReady is the type of the node condition reporting whether the kubelet is ready to accept pods
+	NodeReady = "Ready"
+	// ConditionTrue is the status of a node condition that is met
+	ConditionTrue = "True"
+	// MirrorPodAnnotation is the annotation of the pods the kubelet runs from static manifests
+	MirrorPodAnnotation = "kubernetes.io/config.mirror"
+	// EtcdClientPort is the port the etcd member of each master serves its clients on
+	EtcdClientPort = 2379
+)
+
+// ObjectMeta is the subset of the Kubernetes object metadata used by ACS-Engine
+type ObjectMeta struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace,omitempty"`
+	UID             string            `json:"uid,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	OwnerReferences []OwnerReference  `json:"ownerReferences,omitempty"`
+}
+
+// OwnerReference identifies the object, such as a ReplicaSet or a DaemonSet, owning another object
+type OwnerReference struct {
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Controller *bool  `json:"controller,omitempty"`
+}
+
+// NodeCondition is the state of a node condition
+type NodeCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Node is the subset of a Kubernetes v1 Node used by ACS-Engine
+type Node struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     struct {
+		Unschedulable bool `json:"unschedulable,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []NodeCondition `json:"conditions,omitempty"`
+		NodeInfo   struct {
+			KubeletVersion string `json:"kubeletVersion"`
+		} `json:"nodeInfo"`
+	} `json:"status"`
+}
+
+// IsReady returns true if the Ready condition of the node is met
+func (n *Node) IsReady() bool {
+	return hasCondition(n.Status.Conditions, NodeReady)
+}
+
+// EtcdMember is a member of the etcd cluster of the masters, as listed by the etcd v2 members API.
+// The members are named after their master. A member that was added but has not started yet has no
+// name and no client URLs.
+type EtcdMember struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	PeerURLs   []string `json:"peerURLs"`
+	ClientURLs []string `json:"clientURLs"`
+}
+
+// Pod is the subset of a Kubernetes v1 Pod used by ACS-Engine
+type Pod struct {
+	Metadata ObjectMeta `json:"metadata"`
+	Spec     struct {
+		NodeName string `json:"nodeName,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		Phase string `json:"phase,omitempty"`
+	} `json:"status"`
+}
+
+// IsMirrorPod returns true if the pod is the API server copy of a static pod, which cannot be evicted
+func (p *Pod) IsMirrorPod() bool {
+	_, ok := p.Metadata.Annotations[MirrorPodAnnotation]
+	return ok
+}
+
+// IsDaemonSetPod returns true if the pod is managed by a DaemonSet, and would be recreated on the same node
+func (p *Pod) IsDaemonSetPod() bool {
+	for _, owner := range p.Metadata.OwnerReferences {
+		if owner.Kind == "DaemonSet" && owner.Controller != nil && *owner.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+// IsTerminated returns true if all the containers of the pod have terminated
+func (p *Pod) IsTerminated() bool {
+	return p.Status.Phase == "Succeeded" || p.Status.Phase == "Failed"
+}
+
+// APIError is the error returned when the API server answers a request with an unexpected status
+type APIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s failed with status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// IsNotFound returns true if err is an API server answer that the object does not exist
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsTooManyRequests returns true if err is an API server answer that the request must be retried
+// later, which is how an eviction disallowed by a PodDisruptionBudget is rejected
+func IsTooManyRequests(err error) bool {
+	apiErr, ok := err.(*APIError)
+	return ok && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+func hasCondition(conditions []NodeCondition, conditionType string) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == ConditionTrue
+		}
+	}
+	return false
+}
+
+// kubeConfig is the subset of a kubeconfig file, as generated by ACS-Engine, needed to reach the API server
+type kubeConfig struct {
+	Clusters []struct {
+		Cluster struct {
+			Server                   string `json:"server"`
+			CertificateAuthorityData string `json:"certificate-authority-data"`
+		} `json:"cluster"`
+	} `json:"clusters"`
+	Users []struct {
+		User struct {
+			ClientCertificateData string `json:"client-certificate-data"`
+			ClientKeyData         string `json:"client-key-data"`
+		} `json:"user"`
+	} `json:"users"`
+}
+
+// APIClient implements the `Client` interface.
+// This client talks to the Kubernetes API server over HTTPS with the admin certificates.
+type APIClient struct {
+	server     string
+	httpClient *http.Client
+}
+
+// NewClient returns an APIClient for the cluster and admin user of the
+// specified kubeconfig (in the JSON format generated by acsengine.GenerateKubeConfig)
+func NewClient(kubeConfigJSON string, timeout time.Duration) (*APIClient, error) {
+	config := kubeConfig{}
+	if err := json.Unmarshal([]byte(kubeConfigJSON), &config); err != nil {
+		return nil, fmt.Errorf("Failed to parse kubeconfig: %q", err)
+	}
+	if len(config.Clusters) == 0 || config.Clusters[0].Cluster.Server == "" {
+		return nil, fmt.Errorf("kubeconfig does not have a cluster server")
+	}
+	cluster := config.Clusters[0].Cluster
+
+	tlsConfig := &tls.Config{}
+	if cluster.CertificateAuthorityData != "" {
+		caCertificate, err := base64.StdEncoding.DecodeString(cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode certificate authority data: %q", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCertificate) {
+			return nil, fmt.Errorf("Failed to parse certificate authority data")
+		}
+	}
+
+	if len(config.Users) > 0 && config.Users[0].User.ClientCertificateData != "" {
+		user := config.Users[0].User
+		clientCertificate, err := base64.StdEncoding.DecodeString(user.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode client certificate data: %q", err)
+		}
+		clientKey, err := base64.StdEncoding.DecodeString(user.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to decode client key data: %q", err)
+		}
+		keyPair, err := tls.X509KeyPair(clientCertificate, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load client certificate: %q", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	return &APIClient{
+		server: strings.TrimSuffix(cluster.Server, "/"),
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+// GetNode returns the node with the specified name
+func (kc *APIClient) GetNode(name string) (*Node, error) {
+	node := &Node{}
+	if err := kc.get(fmt.Sprintf("/api/v1/nodes/%s", name), node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// ListNodes returns all the nodes of the cluster
+func (kc *APIClient) ListNodes() ([]Node, error) {
+	nodeList := struct {
+		Items []Node `json:"items"`
+	}{}
+	if err := kc.get("/api/v1/nodes", &nodeList); err != nil {
+		return nil, err
+	}
+	return nodeList.Items, nil
+}
+
+// ListEtcdMembers returns the members of the etcd cluster, as listed by the etcd member of the master node.
+// The API server only talks to the etcd member of its own master, so etcd is reached through the node proxy.
+func (kc *APIClient) ListEtcdMembers(masterNodeName string) ([]EtcdMember, error) {
+	memberList := struct {
+		Members []EtcdMember `json:"members"`
+	}{}
+	if err := kc.get(etcdProxyPath(masterNodeName, "/v2/members"), &memberList); err != nil {
+		return nil, err
+	}
+	return memberList.Members, nil
+}
+
+// IsEtcdHealthy returns true if the etcd member of the master node reports healthy. A member is only
+// healthy when the etcd cluster has quorum.
+func (kc *APIClient) IsEtcdHealthy(masterNodeName string) (bool, error) {
+	health := struct {
+		Health string `json:"health"`
+	}{}
+	if err := kc.get(etcdProxyPath(masterNodeName, "/health"), &health); err != nil {
+		return false, err
+	}
+	return health.Health == "true", nil
+}
+
+// etcdProxyPath returns the API server path proxying the request to the etcd member of the master node
+func etcdProxyPath(masterNodeName, path string) string {
+	return fmt.Sprintf("/api/v1/nodes/%s:%d/proxy%s", masterNodeName, EtcdClientPort, path)
+}
+
+// SetNodeUnschedulable cordons (unschedulable is true) or uncordons (unschedulable is false) the node
+func (kc *APIClient) SetNodeUnschedulable(name string, unschedulable bool) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": unschedulable,
+		},
+	}
+	return kc.do("PATCH", fmt.Sprintf("/api/v1/nodes/%s", name), "application/strategic-merge-patch+json", patch, nil)
+}
+
+// ListPods returns the pods scheduled on the specified node
+func (kc *APIClient) ListPods(nodeName string) ([]Pod, error) {
+	podList := struct {
+		Items []Pod `json:"items"`
+	}{}
+	query := url.Values{"fieldSelector": []string{fmt.Sprintf("spec.nodeName=%s", nodeName)}}
+	if err := kc.get("/api/v1/pods?"+query.Encode(), &podList); err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+// GetPod returns the pod with the specified namespace and name
+func (kc *APIClient) GetPod(namespace, name string) (*Pod, error) {
+	pod := &Pod{}
+	if err := kc.get(fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", namespace, name), pod); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+// EvictPod asks the API server to evict the pod, which it only does if no PodDisruptionBudget
+// forbids it. gracePeriodSeconds overrides the termination grace period of the pod, unless it is negative.
+func (kc *APIClient) EvictPod(pod *Pod, gracePeriodSeconds int64) error {
+	eviction := map[string]interface{}{
+		"apiVersion": "policy/v1beta1",
+		"kind":       "Eviction",
+		"metadata": map[string]interface{}{
+			"name":      pod.Metadata.Name,
+			"namespace": pod.Metadata.Namespace,
+		},
+	}
+	if gracePeriodSeconds >= 0 {
+		eviction["deleteOptions"] = map[string]interface{}{
+			"gracePeriodSeconds": gracePeriodSeconds,
+		}
+	}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction", pod.Metadata.Namespace, pod.Metadata.Name)
+	return kc.do("POST", path, "application/json", eviction, nil)
+}
+
+func (kc *APIClient) get(path string, result interface{}) error {
+	return kc.do("GET", path, "", nil, result)
+}
+
+// do sends a request with the JSON encoded body, if any, to the API server and decodes the answer into result, if any
+func (kc *APIClient) do(method, path, contentType string, body interface{}, result interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, kc.server+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := kc.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &APIError{Method: method, Path: path, StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, result)
+}
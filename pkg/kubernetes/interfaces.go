@@ -0,0 +1,28 @@
+package kubernetes
+
+// Client interface models the Kubernetes API server client
+type Client interface {
+	// GetNode returns the node with the specified name
+	GetNode(name string) (*Node, error)
+
+	// ListNodes returns all the nodes of the cluster
+	ListNodes() ([]Node, error)
+
+	// ListEtcdMembers returns the members of the etcd cluster, as listed by the etcd member of the master node
+	ListEtcdMembers(masterNodeName string) ([]EtcdMember, error)
+
+	// IsEtcdHealthy returns true if the etcd member of the master node reports healthy
+	IsEtcdHealthy(masterNodeName string) (bool, error)
+
+	// SetNodeUnschedulable cordons or uncordons the node
+	SetNodeUnschedulable(name string, unschedulable bool) error
+
+	// ListPods returns the pods scheduled on the specified node
+	ListPods(nodeName string) ([]Pod, error)
+
+	// GetPod returns the pod with the specified namespace and name
+	GetPod(namespace, name string) (*Pod, error)
+
+	// EvictPod evicts the pod, unless a PodDisruptionBudget forbids it
+	EvictPod(pod *Pod, gracePeriodSeconds int64) error
+}
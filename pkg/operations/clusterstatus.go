@@ -0,0 +1,215 @@
+package operations
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+const powerStatePrefix = "PowerState/"
+
+// VMStatus is the state of a VM of a cluster, as reported by ARM
+type VMStatus struct {
+	Name              string   `json:"name"`
+	VMSize            string   `json:"vmSize"`
+	ProvisioningState string   `json:"provisioningState"`
+	PowerState        string   `json:"powerState"`
+	Orchestrator      string   `json:"orchestrator"`
+	Problems          []string `json:"problems,omitempty"`
+}
+
+// PoolStatus compares the VMs of the masters or of an agent pool to the api model
+type PoolStatus struct {
+	Name          string     `json:"name"`
+	ExpectedCount int        `json:"expectedCount"`
+	ActualCount   int        `json:"actualCount"`
+	VMSize        string     `json:"vmSize"`
+	VMs           []VMStatus `json:"vms"`
+	Problems      []string   `json:"problems,omitempty"`
+	// Note explains why the VMs of the pool are not inspected, if they are not
+	Note string `json:"note,omitempty"`
+}
+
+// ClusterStatus compares the VMs deployed for a cluster to its api model
+type ClusterStatus struct {
+	ResourceGroup string       `json:"resourceGroup"`
+	ClusterID     string       `json:"clusterID"`
+	Orchestrator  string       `json:"orchestrator"`
+	Masters       PoolStatus   `json:"masters"`
+	AgentPools    []PoolStatus `json:"agentPools"`
+}
+
+// Healthy returns true when no problem was found
+func (s *ClusterStatus) Healthy() bool {
+	for _, pool := range append([]PoolStatus{s.Masters}, s.AgentPools...) {
+		if len(pool.Problems) > 0 {
+			return false
+		}
+		for _, vm := range pool.VMs {
+			if len(vm.Problems) > 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Table returns the status as a table of the pools followed by a table of the VMs
+func (s *ClusterStatus) Table() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "Cluster %s in resource group %s, expected orchestrator %s\n\n", s.ClusterID, s.ResourceGroup, s.Orchestrator)
+
+	pools := append([]PoolStatus{s.Masters}, s.AgentPools...)
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "POOL\tEXPECTED\tACTUAL\tVM SIZE\tPROBLEMS")
+	for _, pool := range pools {
+		actual := fmt.Sprintf("%d", pool.ActualCount)
+		problems := strings.Join(pool.Problems, "; ")
+		if pool.Note != "" {
+			actual, problems = "-", pool.Note
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", pool.Name, pool.ExpectedCount, actual, pool.VMSize, problems)
+	}
+	w.Flush()
+
+	fmt.Fprintln(&b)
+	w = tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "VM\tPOOL\tVM SIZE\tPROVISIONING\tPOWER\tORCHESTRATOR\tPROBLEMS")
+	for _, pool := range pools {
+		for _, vm := range pool.VMs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", vm.Name, pool.Name, vm.VMSize, vm.ProvisioningState,
+				vm.PowerState, vm.Orchestrator, strings.Join(vm.Problems, "; "))
+		}
+	}
+	w.Flush()
+
+	return b.String()
+}
+
+// GetClusterStatus lists the VMs of the Kubernetes cluster in the resource group, groups them into the
+// masters and the agent pools by their tags or names, and reports every difference with the api model: VM counts,
+// VM sizes, provisioning and power states, and orchestrator version tags
+func GetClusterStatus(az armhelpers.ACSEngineClient, cs *api.ContainerService, resourceGroup string) (*ClusterStatus, error) {
+	properties := cs.Properties
+	if !properties.OrchestratorProfile.IsKubernetes() {
+		return nil, fmt.Errorf("status is only supported for Kubernetes clusters, not %s", properties.OrchestratorProfile.OrchestratorType)
+	}
+
+	vmList, err := az.ListVirtualMachines(resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the VMs of resource group %s: %s", resourceGroup, err.Error())
+	}
+	vms := []compute.VirtualMachine{}
+	if vmList.Value != nil {
+		vms = *vmList.Value
+	}
+	sort.Sort(armhelpers.ByVMNameOffset(vms))
+
+	// the VMs are grouped by pool, masters included
+	pools := map[string][]compute.VirtualMachine{}
+	for _, vm := range vms {
+		if clusterVM, ok := IdentifyClusterVM(properties, vm); ok {
+			pools[clusterVM.PoolName] = append(pools[clusterVM.PoolName], vm)
+		}
+	}
+
+	status := &ClusterStatus{
+		ResourceGroup: resourceGroup,
+		ClusterID:     acsengine.GenerateClusterID(properties),
+		Orchestrator:  fmt.Sprintf("%s:%s", properties.OrchestratorProfile.OrchestratorType, properties.OrchestratorProfile.OrchestratorVersion),
+	}
+
+	status.Masters, err = poolStatus(az, resourceGroup, MasterRole, properties.MasterProfile.Count, properties.MasterProfile.VMSize,
+		pools[MasterRole], status.Orchestrator)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, agentPool := range properties.AgentPoolProfiles {
+		if !agentPool.IsAvailabilitySets() {
+			status.AgentPools = append(status.AgentPools, PoolStatus{
+				Name:          agentPool.Name,
+				ExpectedCount: agentPool.Count,
+				VMSize:        agentPool.VMSize,
+				VMs:           []VMStatus{},
+				Note:          "scale set instances are not inspected",
+			})
+			continue
+		}
+
+		pool, err := poolStatus(az, resourceGroup, agentPool.Name, agentPool.Count, agentPool.VMSize,
+			pools[agentPool.Name], status.Orchestrator)
+		if err != nil {
+			return nil, err
+		}
+		status.AgentPools = append(status.AgentPools, pool)
+	}
+
+	return status, nil
+}
+
+// poolStatus returns the status of the VMs of a pool
+func poolStatus(az armhelpers.ACSEngineClient, resourceGroup, name string, count int, vmSize string,
+	vms []compute.VirtualMachine, orchestrator string) (PoolStatus, error) {
+	pool := PoolStatus{Name: name, ExpectedCount: count, VMSize: vmSize, VMs: []VMStatus{}}
+
+	for _, vm := range vms {
+		vmStatus := VMStatus{Name: to.String(vm.Name)}
+		if vm.Tags != nil {
+			vmStatus.Orchestrator = tagValue(*vm.Tags, "orchestrator")
+		}
+		if vm.VirtualMachineProperties != nil {
+			vmStatus.ProvisioningState = to.String(vm.ProvisioningState)
+			if vm.HardwareProfile != nil {
+				vmStatus.VMSize = string(vm.HardwareProfile.VMSize)
+			}
+		}
+
+		// the VM list does not include the power state
+		instanceView, err := az.GetVirtualMachineInstanceView(resourceGroup, vmStatus.Name)
+		if err != nil {
+			return pool, fmt.Errorf("error getting the instance view of VM %s: %s", vmStatus.Name, err.Error())
+		}
+		vmStatus.PowerState = powerState(instanceView)
+
+		if !strings.EqualFold(vmStatus.VMSize, vmSize) {
+			vmStatus.Problems = append(vmStatus.Problems, fmt.Sprintf("VM size is %s, expected %s", vmStatus.VMSize, vmSize))
+		}
+		if vmStatus.ProvisioningState != "Succeeded" {
+			vmStatus.Problems = append(vmStatus.Problems, fmt.Sprintf("provisioning state is %s", vmStatus.ProvisioningState))
+		}
+		if vmStatus.PowerState != "running" {
+			vmStatus.Problems = append(vmStatus.Problems, fmt.Sprintf("power state is %s", vmStatus.PowerState))
+		}
+		if vmStatus.Orchestrator != orchestrator {
+			vmStatus.Problems = append(vmStatus.Problems, fmt.Sprintf("orchestrator tag is %s, expected %s", vmStatus.Orchestrator, orchestrator))
+		}
+		pool.VMs = append(pool.VMs, vmStatus)
+	}
+
+	pool.ActualCount = len(pool.VMs)
+	if pool.ActualCount != pool.ExpectedCount {
+		pool.Problems = append(pool.Problems, fmt.Sprintf("expected %d VMs, found %d", pool.ExpectedCount, pool.ActualCount))
+	}
+	return pool, nil
+}
+
+// powerState returns the power state of the instance view, such as running or deallocated
+func powerState(instanceView compute.VirtualMachineInstanceView) string {
+	if instanceView.Statuses != nil {
+		for _, status := range *instanceView.Statuses {
+			if code := to.String(status.Code); strings.HasPrefix(code, powerStatePrefix) {
+				return strings.TrimPrefix(code, powerStatePrefix)
+			}
+		}
+	}
+	return "unknown"
+}
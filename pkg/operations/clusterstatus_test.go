@@ -0,0 +1,75 @@
+package operations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func newStatusTestVM(name, version string, vmSize compute.VirtualMachineSizeTypes, powerState string) compute.VirtualMachine {
+	vm := newTestVM(name, map[string]*string{"orchestrator": to.StringPtr("Kubernetes:" + version)}, false)
+	vm.ProvisioningState = to.StringPtr("Succeeded")
+	vm.HardwareProfile = &compute.HardwareProfile{VMSize: vmSize}
+	vm.InstanceView = &compute.VirtualMachineInstanceView{
+		Statuses: &[]compute.InstanceViewStatus{
+			{Code: to.StringPtr("ProvisioningState/succeeded")},
+			{Code: to.StringPtr("PowerState/" + powerState)},
+		},
+	}
+	return vm
+}
+
+func TestGetClusterStatus(t *testing.T) {
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := armhelpers.NewFakeACSEngineClient()
+	masterPrefix := acsengine.GetK8sMasterVMNamePrefix(cs.Properties)
+	agentPrefix := acsengine.GetK8sAgentVMNamePrefix(cs.Properties, 0)
+	client.AddVirtualMachine(testResourceGroup, newStatusTestVM(masterPrefix+"0", "1.5.3", compute.StandardD2V2, "running"))
+	client.AddVirtualMachine(testResourceGroup, newStatusTestVM(agentPrefix+"0", "1.5.3", compute.StandardD2V2, "running"))
+
+	status, err := GetClusterStatus(client, cs, testResourceGroup)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if problems := status.AgentPools[0].Problems; len(problems) != 1 || problems[0] != "expected 2 VMs, found 1" {
+		t.Fatalf("expected the missing agent to be reported, got %v", problems)
+	}
+	if len(status.AgentPools[0].VMs[0].Problems) != 0 {
+		t.Fatalf("expected no problem with the agent:\n%s", status.Table())
+	}
+
+	client.AddVirtualMachine(testResourceGroup, newStatusTestVM(agentPrefix+"1", "1.6.2", compute.StandardD4V2, "deallocated"))
+	if status, err = GetClusterStatus(client, cs, testResourceGroup); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if status.Healthy() {
+		t.Fatalf("expected the cluster not to be healthy")
+	}
+	if status.Masters.ActualCount != 1 || len(status.Masters.Problems) != 0 {
+		t.Fatalf("incorrect master status: %+v", status.Masters)
+	}
+
+	pool := status.AgentPools[0]
+	if pool.ExpectedCount != 2 || pool.ActualCount != 2 || len(pool.Problems) != 0 {
+		t.Fatalf("incorrect agent pool status: %+v", pool)
+	}
+	problems := strings.Join(pool.VMs[1].Problems, "; ")
+	for _, expected := range []string{
+		"VM size is Standard_D4_v2, expected Standard_D2_v2",
+		"power state is deallocated",
+		"orchestrator tag is Kubernetes:1.6.2, expected Kubernetes:1.5.3",
+	} {
+		if !strings.Contains(problems, expected) {
+			t.Fatalf("expected problem %q, got %q", expected, problems)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package operations
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+const (
+	// RoleTag is the tag recording whether a VM is a master or an agent
+	RoleTag = "role"
+	// PoolNameTag is the tag recording the agent pool of a VM, master for the masters
+	PoolNameTag = "poolName"
+	// ClusterIDTag is the tag recording the cluster ID of a VM, see acsengine.GenerateClusterID
+	ClusterIDTag = "clusterID"
+	// NodeIndexTag is the tag recording the index of a VM in its pool
+	NodeIndexTag = "nodeIndex"
+
+	// MasterRole is the role of the master VMs
+	MasterRole = "master"
+	// AgentRole is the role of the agent VMs
+	AgentRole = "agent"
+)
+
+// ClusterVM identifies a VM of a cluster
+type ClusterVM struct {
+	Role      string
+	PoolName  string
+	NodeIndex int
+}
+
+// IdentifyClusterVM returns the role, pool and node index of the VM in the Kubernetes cluster of the api model.
+// They are read from the tags of the VM, or derived from its name for the VMs deployed without these tags.
+// ok is false for the VMs that do not belong to the cluster.
+func IdentifyClusterVM(properties *api.Properties, vm compute.VirtualMachine) (clusterVM ClusterVM, ok bool) {
+	if vm.Tags != nil && tagValue(*vm.Tags, RoleTag) != "" {
+		return identifyClusterVMByTags(properties, *vm.Tags)
+	}
+	return identifyClusterVMByName(properties, to.String(vm.Name))
+}
+
+func identifyClusterVMByTags(properties *api.Properties, tags map[string]*string) (ClusterVM, bool) {
+	if tagValue(tags, ClusterIDTag) != acsengine.GenerateClusterID(properties) {
+		return ClusterVM{}, false
+	}
+	index, err := strconv.Atoi(tagValue(tags, NodeIndexTag))
+	if err != nil {
+		return ClusterVM{}, false
+	}
+
+	switch role, poolName := tagValue(tags, RoleTag), tagValue(tags, PoolNameTag); role {
+	case MasterRole:
+		return ClusterVM{Role: MasterRole, PoolName: MasterRole, NodeIndex: index}, true
+	case AgentRole:
+		// the pool names of the api model are used, as the tags may differ in case
+		for _, agentPool := range properties.AgentPoolProfiles {
+			if strings.EqualFold(agentPool.Name, poolName) {
+				poolName = agentPool.Name
+			}
+		}
+		return ClusterVM{Role: AgentRole, PoolName: poolName, NodeIndex: index}, true
+	default:
+		return ClusterVM{}, false
+	}
+}
+
+func identifyClusterVMByName(properties *api.Properties, name string) (ClusterVM, bool) {
+	clusterID := acsengine.GenerateClusterID(properties)
+
+	if poolName, vmClusterID, index, err := armhelpers.K8sLinuxVMNameParts(name); err == nil {
+		if vmClusterID != clusterID {
+			return ClusterVM{}, false
+		}
+		if poolName == MasterRole {
+			return ClusterVM{Role: MasterRole, PoolName: MasterRole, NodeIndex: index}, true
+		}
+		for _, agentPool := range properties.AgentPoolProfiles {
+			if !agentPool.IsWindows() && agentPool.Name == poolName {
+				return ClusterVM{Role: AgentRole, PoolName: agentPool.Name, NodeIndex: index}, true
+			}
+		}
+		return ClusterVM{}, false
+	}
+
+	// the names of the Windows VMs only have the index of their pool
+	if clusterIDPrefix, poolIndex, index, err := armhelpers.K8sWindowsVMNameParts(name); err == nil {
+		if clusterIDPrefix != clusterID[:len(clusterIDPrefix)] || poolIndex < 0 || poolIndex >= len(properties.AgentPoolProfiles) ||
+			!properties.AgentPoolProfiles[poolIndex].IsWindows() {
+			return ClusterVM{}, false
+		}
+		return ClusterVM{Role: AgentRole, PoolName: properties.AgentPoolProfiles[poolIndex].Name, NodeIndex: index}, true
+	}
+
+	return ClusterVM{}, false
+}
@@ -0,0 +1,52 @@
+package operations
+
+import (
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestIdentifyClusterVM(t *testing.T) {
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	properties := cs.Properties
+	clusterID := acsengine.GenerateClusterID(properties)
+
+	tagged := func(name, role, poolName, clusterID, nodeIndex string) compute.VirtualMachine {
+		return compute.VirtualMachine{
+			Name: to.StringPtr(name),
+			Tags: &map[string]*string{
+				RoleTag:      to.StringPtr(role),
+				PoolNameTag:  to.StringPtr(poolName),
+				ClusterIDTag: to.StringPtr(clusterID),
+				NodeIndexTag: to.StringPtr(nodeIndex),
+			},
+		}
+	}
+
+	cases := []struct {
+		vm       compute.VirtualMachine
+		expected ClusterVM
+		ok       bool
+	}{
+		{tagged("any-name", AgentRole, "AgentPool1", clusterID, "3"), ClusterVM{AgentRole, "agentpool1", 3}, true},
+		{tagged("any-name", MasterRole, MasterRole, clusterID, "2"), ClusterVM{MasterRole, MasterRole, 2}, true},
+		{tagged(acsengine.GetK8sMasterVMNamePrefix(properties)+"0", MasterRole, MasterRole, "12345678", "0"), ClusterVM{}, false},
+		{tagged("any-name", AgentRole, "agentpool1", clusterID, "x"), ClusterVM{}, false},
+		{compute.VirtualMachine{Name: to.StringPtr(acsengine.GetK8sMasterVMNamePrefix(properties) + "1")}, ClusterVM{MasterRole, MasterRole, 1}, true},
+		{compute.VirtualMachine{Name: to.StringPtr(acsengine.GetK8sAgentVMNamePrefix(properties, 0) + "12")}, ClusterVM{AgentRole, "agentpool1", 12}, true},
+		{compute.VirtualMachine{Name: to.StringPtr("k8s-other-12345678-0")}, ClusterVM{}, false},
+	}
+
+	for _, c := range cases {
+		clusterVM, ok := IdentifyClusterVM(properties, c.vm)
+		if ok != c.ok || clusterVM != c.expected {
+			t.Errorf("VM %s: expected %+v %t, got %+v %t", *c.vm.Name, c.expected, c.ok, clusterVM, ok)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package operations
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/Sirupsen/logrus"
+)
+
+const (
+	// CreationSourceTag is the tag recording what created a resource
+	CreationSourceTag = "creationSource"
+	// ResourceNameSuffixTag is the tag recording the cluster ID suffixing the names of the resources of a cluster
+	ResourceNameSuffixTag = "resourceNameSuffix"
+
+	acsEngineCreationSource = "acsengine"
+)
+
+// deletionOrder lists the resource types in the order their resources can be deleted, as a resource
+// cannot be deleted while another resource references it. Other types are deleted last.
+var deletionOrder = []string{
+	"Microsoft.Compute/virtualMachines",
+	"Microsoft.Compute/virtualMachineScaleSets",
+	"Microsoft.Network/networkInterfaces",
+	"Microsoft.Compute/disks",
+	"Microsoft.Compute/availabilitySets",
+	"Microsoft.Network/loadBalancers",
+	"Microsoft.Network/publicIPAddresses",
+	"Microsoft.Network/virtualNetworks",
+	"Microsoft.Network/networkSecurityGroups",
+	"Microsoft.Network/routeTables",
+}
+
+// ResourceGroupTags returns the tags of a resource group acs-engine creates for a cluster
+func ResourceGroupTags(clusterID string) map[string]*string {
+	return map[string]*string{
+		CreationSourceTag:     to.StringPtr(acsEngineCreationSource),
+		ResourceNameSuffixTag: to.StringPtr(clusterID),
+	}
+}
+
+// ClusterDeletionReport lists what a cluster deletion removed, or would remove on a dry run
+type ClusterDeletionReport struct {
+	ResourceGroup string
+	DryRun        bool
+	// ResourceGroupDeleted is true when the resource group was created by acs-engine for the cluster, and deleted with it
+	ResourceGroupDeleted bool
+	// Resources are the identifiers of the resources removed, in deletion order
+	Resources []string
+	// Blobs are the VHDs of the VMs removed, as <account>/<container>/<blob>
+	Blobs []string
+}
+
+// String returns the report as a list of what was removed
+func (r *ClusterDeletionReport) String() string {
+	verb := "deleted"
+	if r.DryRun {
+		verb = "would delete"
+	}
+
+	var b bytes.Buffer
+	if r.ResourceGroupDeleted {
+		fmt.Fprintf(&b, "%s resource group %s, with its %d resources:\n", verb, r.ResourceGroup, len(r.Resources))
+	} else {
+		fmt.Fprintf(&b, "%s %d resources and %d blobs of resource group %s:\n", verb, len(r.Resources), len(r.Blobs), r.ResourceGroup)
+	}
+	for _, resource := range r.Resources {
+		fmt.Fprintf(&b, "  %s\n", resource)
+	}
+	for _, blob := range r.Blobs {
+		fmt.Fprintf(&b, "  blob %s\n", blob)
+	}
+	return b.String()
+}
+
+// DeleteCluster deletes the cluster of the api model properties. The resource group is deleted when acs-engine
+// created it for the cluster. Otherwise only the resources of the cluster are deleted, see isClusterResource,
+// together with the VHD blobs of the VMs. On a dry run nothing is deleted, and the report lists what would be.
+func DeleteCluster(ctx context.Context, logger *logrus.Entry, az armhelpers.ACSEngineClient, resourceGroup string, properties *api.Properties, dryRun bool) (*ClusterDeletionReport, error) {
+	report := &ClusterDeletionReport{ResourceGroup: resourceGroup, DryRun: dryRun}
+	clusterID := acsengine.GenerateClusterID(properties)
+	namePrefixes := clusterNamePrefixes(properties)
+
+	group, err := az.GetResourceGroup(resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource group %s: %s", resourceGroup, err.Error())
+	}
+	list, err := az.ListResources(resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the resources of resource group %s: %s", resourceGroup, err.Error())
+	}
+
+	if group.Tags != nil && tagValue(*group.Tags, CreationSourceTag) == acsEngineCreationSource &&
+		tagValue(*group.Tags, ResourceNameSuffixTag) == clusterID {
+		report.ResourceGroupDeleted = true
+		if list.Value != nil {
+			for _, resource := range *list.Value {
+				report.Resources = append(report.Resources, to.String(resource.ID))
+			}
+		}
+		sort.Strings(report.Resources)
+		if dryRun {
+			return report, nil
+		}
+
+		logger.Infof("deleting resource group: %s", resourceGroup)
+		_, errChan := az.DeleteResourceGroup(ctx, resourceGroup)
+		if err := <-errChan; err != nil {
+			return nil, fmt.Errorf("error deleting resource group %s: %s", resourceGroup, err.Error())
+		}
+		return report, nil
+	}
+
+	tiers := make([][]string, len(deletionOrder)+1)
+	if list.Value != nil {
+		for _, resource := range *list.Value {
+			if !isClusterResource(to.String(resource.Name), resource.Tags, clusterID, namePrefixes) {
+				continue
+			}
+			tier := len(deletionOrder)
+			for i, resourceType := range deletionOrder {
+				if strings.EqualFold(to.String(resource.Type), resourceType) {
+					tier = i
+				}
+			}
+			tiers[tier] = append(tiers[tier], to.String(resource.ID))
+		}
+	}
+
+	// the VHDs are not resources, they are found through the VMs
+	vhds, err := clusterVHDs(az, resourceGroup, clusterID, namePrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tier := range tiers {
+		sort.Strings(tier)
+		if dryRun {
+			report.Resources = append(report.Resources, tier...)
+			continue
+		}
+
+		// the resources of a tier do not depend on each other, they are deleted in parallel
+		errChans := []<-chan error{}
+		for _, resourceID := range tier {
+			logger.Infof("deleting resource: %s", resourceID)
+			_, errChan := az.DeleteResource(ctx, resourceID)
+			errChans = append(errChans, errChan)
+		}
+		for i, errChan := range errChans {
+			if err := <-errChan; err != nil {
+				return report, fmt.Errorf("error deleting resource %s: %s", tier[i], err.Error())
+			}
+		}
+		report.Resources = append(report.Resources, tier...)
+	}
+
+	for _, vhd := range vhds {
+		accountName, container, blob, err := armhelpers.SplitBlobURI(vhd)
+		if err != nil {
+			return report, err
+		}
+		if dryRun {
+			report.Blobs = append(report.Blobs, fmt.Sprintf("%s/%s/%s", accountName, container, blob))
+			continue
+		}
+
+		logger.Infof("deleting blob: %s/%s/%s", accountName, container, blob)
+		as, err := az.GetStorageClient(resourceGroup, accountName)
+		if err != nil {
+			return report, err
+		}
+		if err := as.DeleteBlob(container, blob); err != nil {
+			return report, fmt.Errorf("error deleting blob %s/%s/%s: %s", accountName, container, blob, err.Error())
+		}
+		report.Blobs = append(report.Blobs, fmt.Sprintf("%s/%s/%s", accountName, container, blob))
+	}
+
+	return report, nil
+}
+
+// clusterVHDs returns the URIs of the OS and data disk VHDs of the VMs of the cluster
+func clusterVHDs(az armhelpers.ACSEngineClient, resourceGroup, clusterID string, namePrefixes []string) ([]string, error) {
+	vms, err := az.ListVirtualMachines(resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the VMs of resource group %s: %s", resourceGroup, err.Error())
+	}
+
+	vhds := []string{}
+	if vms.Value == nil {
+		return vhds, nil
+	}
+	for _, vm := range *vms.Value {
+		if !isClusterResource(to.String(vm.Name), vm.Tags, clusterID, namePrefixes) ||
+			vm.VirtualMachineProperties == nil || vm.StorageProfile == nil {
+			continue
+		}
+		disks := []*compute.VirtualHardDisk{}
+		if vm.StorageProfile.OsDisk != nil {
+			disks = append(disks, vm.StorageProfile.OsDisk.Vhd)
+		}
+		if vm.StorageProfile.DataDisks != nil {
+			for _, dataDisk := range *vm.StorageProfile.DataDisks {
+				disks = append(disks, dataDisk.Vhd)
+			}
+		}
+		for _, vhd := range disks {
+			if vhd != nil && vhd.URI != nil {
+				vhds = append(vhds, *vhd.URI)
+			}
+		}
+	}
+	sort.Strings(vhds)
+	return vhds, nil
+}
+
+// isClusterResource returns true if the resource is tagged with the cluster ID, or named after it.
+// The templates only tag the VMs, the names of the other resources contain the cluster ID, except
+// for the resources of the Windows agent pools, named after the pool name prefixes.
+func isClusterResource(name string, tags *map[string]*string, clusterID string, namePrefixes []string) bool {
+	if tags != nil && (tagValue(*tags, ResourceNameSuffixTag) == clusterID || tagValue(*tags, ClusterIDTag) == clusterID) {
+		return true
+	}
+	if strings.Contains(strings.ToLower(name), strings.ToLower(clusterID)) {
+		return true
+	}
+	for _, prefix := range namePrefixes {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterNamePrefixes returns the name prefixes of the VMs and NICs of the Kubernetes Windows agent pools,
+// which only contain the first characters of the cluster ID, see acsengine.GetK8sAgentVMNamePrefix
+func clusterNamePrefixes(properties *api.Properties) []string {
+	prefixes := []string{}
+	if properties.OrchestratorProfile == nil || !properties.OrchestratorProfile.IsKubernetes() {
+		return prefixes
+	}
+	for i, agentPool := range properties.AgentPoolProfiles {
+		if agentPool.IsWindows() {
+			prefixes = append(prefixes, acsengine.GetK8sAgentVMNamePrefix(properties, i))
+		}
+	}
+	return prefixes
+}
+
+func tagValue(tags map[string]*string, name string) string {
+	for key, value := range tags {
+		if strings.EqualFold(key, name) {
+			return to.String(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// newTestCluster returns the properties of a Kubernetes cluster with a Linux and a Windows agent pool, and a client
+// with its VMs, load balancer and virtual network, and a VM and a virtual network of another cluster, in testResourceGroup
+func newTestCluster(t *testing.T) (*api.Properties, *armhelpers.FakeACSEngineClient) {
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	properties := cs.Properties
+	properties.AgentPoolProfiles = append(properties.AgentPoolProfiles, &api.AgentPoolProfile{
+		Name:                "winpool",
+		Count:               1,
+		VMSize:              "Standard_D2_v2",
+		OSType:              api.Windows,
+		AvailabilityProfile: api.AvailabilitySet,
+	})
+	clusterID := acsengine.GenerateClusterID(properties)
+	windowsPrefix := acsengine.GetK8sAgentVMNamePrefix(properties, 1)
+
+	client := armhelpers.NewFakeACSEngineClient()
+	tags := map[string]*string{ResourceNameSuffixTag: to.StringPtr(clusterID), ClusterIDTag: to.StringPtr(clusterID)}
+	// the Windows VMs are tagged with the first characters of the cluster ID only
+	windowsTags := map[string]*string{ResourceNameSuffixTag: to.StringPtr(clusterID[:5]), ClusterIDTag: to.StringPtr(clusterID)}
+	client.AddVirtualMachine(testResourceGroup, newTestVM(acsengine.GetK8sMasterVMNamePrefix(properties)+"0", tags, false))
+	client.AddVirtualMachine(testResourceGroup, newTestVM(acsengine.GetK8sAgentVMNamePrefix(properties, 0)+"0", tags, true))
+	client.AddVirtualMachine(testResourceGroup, newTestVM(windowsPrefix+"0", windowsTags, false))
+	client.AddVirtualMachine(testResourceGroup, newTestVM("k8s-master-12345678-0", nil, false))
+
+	rg := client.ResourceGroups[testResourceGroup]
+	// the NICs of the Windows VMs are not tagged, they are named after the pool name prefix
+	rg.NetworkInterfaces[strings.ToLower(windowsPrefix+"nic-0")] = true
+	rg.OtherResources["k8s-master-lb-"+clusterID] = "Microsoft.Network/loadBalancers"
+	rg.OtherResources["k8s-vnet-"+clusterID] = "Microsoft.Network/virtualNetworks"
+	rg.OtherResources["k8s-vnet-12345678"] = "Microsoft.Network/virtualNetworks"
+	return properties, client
+}
+
+func TestDeleteCluster(t *testing.T) {
+	properties, client := newTestCluster(t)
+
+	report, err := DeleteCluster(context.Background(), testLogger, client, testResourceGroup, properties, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if report.ResourceGroupDeleted {
+		t.Fatalf("expected the resource group not created by acs-engine to be preserved")
+	}
+
+	rg := client.ResourceGroups[testResourceGroup]
+	if len(rg.VirtualMachines) != 1 || len(rg.NetworkInterfaces) != 2 || len(rg.ManagedDisks) != 0 || len(rg.OtherResources) != 1 {
+		t.Fatalf("expected only the resources of the other cluster to remain: %d VMs, %d NICs, %d disks, other resources %v",
+			len(rg.VirtualMachines), len(rg.NetworkInterfaces), len(rg.ManagedDisks), rg.OtherResources)
+	}
+	// 3 VMs, 7 NICs, 2 managed disks, the load balancer and the virtual network
+	if len(report.Resources) != 14 {
+		t.Fatalf("expected 14 resources to be reported, got %v", report.Resources)
+	}
+	blobs := rg.StorageAccounts["teststorage"].Blobs
+	for blob, exists := range blobs {
+		if exists && !strings.Contains(blob, "k8s-master-12345678-0") {
+			t.Fatalf("expected the VHDs of the cluster to be deleted, %s remains", blob)
+		}
+	}
+	if !blobs["osdisk/k8s-master-12345678-0-osdisk.vhd"] {
+		t.Fatalf("expected the VHDs of the other cluster to be preserved")
+	}
+	if len(report.Blobs) != 4 {
+		t.Fatalf("expected 4 blobs to be reported, got %v", report.Blobs)
+	}
+}
+
+func TestDeleteClusterDryRun(t *testing.T) {
+	properties, client := newTestCluster(t)
+
+	report, err := DeleteCluster(context.Background(), testLogger, client, testResourceGroup, properties, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(report.Resources) != 14 || len(report.Blobs) != 4 {
+		t.Fatalf("expected 14 resources and 4 blobs to be reported, got %v and %v", report.Resources, report.Blobs)
+	}
+	if client.CallCount("DeleteResource") != 0 || client.CallCount("DeleteBlob") != 0 {
+		t.Fatalf("expected nothing to be deleted on a dry run")
+	}
+}
+
+func TestDeleteClusterResourceGroup(t *testing.T) {
+	properties, client := newTestCluster(t)
+	client.ResourceGroups[testResourceGroup].Tags = ResourceGroupTags(acsengine.GenerateClusterID(properties))
+
+	report, err := DeleteCluster(context.Background(), testLogger, client, testResourceGroup, properties, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !report.ResourceGroupDeleted {
+		t.Fatalf("expected the resource group created by acs-engine to be deleted")
+	}
+	if _, ok := client.ResourceGroups[testResourceGroup]; ok {
+		t.Fatalf("expected the resource group to be deleted")
+	}
+}
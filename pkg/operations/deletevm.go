@@ -1,69 +1,157 @@
 package operations
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/acs-engine/pkg/armhelpers"
-	"github.com/prometheus/common/log"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Sirupsen/logrus"
 )
 
-// CleanDeleteVirtualMachine deletes a VM and any associated OS disk
-func CleanDeleteVirtualMachine(az armhelpers.ACSEngineClient, resourceGroup, name string) error {
-	log.Infof("fetching VM: %s/%s", resourceGroup, name)
+// managedDisk identifies a managed disk, which can live in another resource group than its VM
+type managedDisk struct {
+	resourceGroup string
+	name          string
+}
+
+// CleanDeleteVirtualMachine deletes a VM, its NICs, its OS disk, and its managed data disks.
+// Data disks stored as blobs, such as the etcd disk of the masters, are preserved.
+func CleanDeleteVirtualMachine(ctx context.Context, logger *logrus.Entry, az armhelpers.ACSEngineClient, resourceGroup, name string) error {
+	logger.Infof("fetching VM: %s/%s", resourceGroup, name)
 	vm, err := az.GetVirtualMachine(resourceGroup, name)
 	if err != nil {
-		log.Errorf("failed to get VM: %s/%s: %s", resourceGroup, name, err.Error())
+		logger.Errorf("failed to get VM: %s/%s: %s", resourceGroup, name, err.Error())
 		return err
 	}
 
-	// NOTE: This code assumes a non-managed disk!
-	vhd := vm.VirtualMachineProperties.StorageProfile.OsDisk.Vhd
-	if vhd == nil {
-		log.Warnf("found an OS Disk with no VHD URI. This is probably a VM with a managed disk")
-		return fmt.Errorf("os disk does not have a VHD URI")
-	}
-	accountName, vhdContainer, vhdBlob, err := armhelpers.SplitBlobURI(*vhd.URI)
-	if err != nil {
-		return err
+	var osDiskVhd *compute.VirtualHardDisk
+	managedDisks := []managedDisk{}
+	if storageProfile := vm.VirtualMachineProperties.StorageProfile; storageProfile != nil {
+		if osDisk := storageProfile.OsDisk; osDisk != nil {
+			if osDisk.ManagedDisk != nil {
+				md, err := getManagedDisk(resourceGroup, osDisk.ManagedDisk, osDisk.Name)
+				if err != nil {
+					return err
+				}
+				managedDisks = append(managedDisks, md)
+			} else if osDisk.Vhd != nil && osDisk.Vhd.URI != nil {
+				osDiskVhd = osDisk.Vhd
+			} else {
+				return fmt.Errorf("os disk of VM %s/%s is neither a managed disk nor a VHD", resourceGroup, name)
+			}
+		}
+		if storageProfile.DataDisks != nil {
+			for _, dataDisk := range *storageProfile.DataDisks {
+				if dataDisk.ManagedDisk == nil {
+					continue
+				}
+				md, err := getManagedDisk(resourceGroup, dataDisk.ManagedDisk, dataDisk.Name)
+				if err != nil {
+					return err
+				}
+				managedDisks = append(managedDisks, md)
+			}
+		}
 	}
 
-	nicID := (*vm.VirtualMachineProperties.NetworkProfile.NetworkInterfaces)[0].ID
-	nicName, err := armhelpers.ResourceName(*nicID)
-	if err != nil {
-		return err
+	nicNames := []string{}
+	if networkProfile := vm.VirtualMachineProperties.NetworkProfile; networkProfile != nil && networkProfile.NetworkInterfaces != nil {
+		for _, nic := range *networkProfile.NetworkInterfaces {
+			if nic.ID == nil {
+				continue
+			}
+			nicName, err := armhelpers.ResourceName(*nic.ID)
+			if err != nil {
+				return err
+			}
+			logger.Infof("found nic name for VM (%s/%s): %s", resourceGroup, name, nicName)
+			nicNames = append(nicNames, nicName)
+		}
 	}
 
-	log.Infof("found os disk storage reference: %s %s %s", accountName, vhdContainer, vhdBlob)
-	log.Infof("found nic name for VM (%s/%s): %s", resourceGroup, name, nicName)
+	var as armhelpers.ACSStorageClient
+	var vhdContainer, vhdBlob string
+	if osDiskVhd != nil {
+		var accountName string
+		accountName, vhdContainer, vhdBlob, err = armhelpers.SplitBlobURI(*osDiskVhd.URI)
+		if err != nil {
+			return err
+		}
+		logger.Infof("found os disk storage reference: %s %s %s", accountName, vhdContainer, vhdBlob)
 
-	log.Infof("deleting VM: %s/%s", resourceGroup, name)
-	_, deleteErrChan := az.DeleteVirtualMachine(resourceGroup, name, nil)
-
-	as, err := az.GetStorageClient(resourceGroup, accountName)
-	if err != nil {
-		return err
+		if as, err = az.GetStorageClient(resourceGroup, accountName); err != nil {
+			return err
+		}
+	}
+	for _, md := range managedDisks {
+		logger.Infof("found managed disk for VM (%s/%s): %s/%s", resourceGroup, name, md.resourceGroup, md.name)
 	}
 
-	log.Infof("waiting for vm deletion: %s/%s", resourceGroup, name)
+	logger.Infof("deleting VM: %s/%s", resourceGroup, name)
+	_, deleteErrChan := az.DeleteVirtualMachine(ctx, resourceGroup, name)
+
+	logger.Infof("waiting for vm deletion: %s/%s", resourceGroup, name)
 	if err := <-deleteErrChan; err != nil {
 		return err
 	}
 
-	log.Infof("deleting nic: %s/%s", resourceGroup, nicName)
-	_, nicErrChan := az.DeleteNetworkInterface(resourceGroup, nicName, nil)
-	if err != nil {
-		return err
+	// the NICs and disks are only released once the VM is gone, then they can be deleted in parallel
+	nicErrChans := []<-chan error{}
+	for _, nicName := range nicNames {
+		logger.Infof("deleting nic: %s/%s", resourceGroup, nicName)
+		_, nicErrChan := az.DeleteNetworkInterface(ctx, resourceGroup, nicName)
+		nicErrChans = append(nicErrChans, nicErrChan)
 	}
 
-	log.Infof("deleting blob: %s/%s", vhdContainer, vhdBlob)
-	if err = as.DeleteBlob(vhdContainer, vhdBlob); err != nil {
-		return err
+	diskErrChans := []<-chan error{}
+	for _, md := range managedDisks {
+		logger.Infof("deleting managed disk: %s/%s", md.resourceGroup, md.name)
+		_, diskErrChan := az.DeleteManagedDisk(ctx, md.resourceGroup, md.name)
+		diskErrChans = append(diskErrChans, diskErrChan)
+	}
+
+	if as != nil {
+		logger.Infof("deleting blob: %s/%s", vhdContainer, vhdBlob)
+		if err = as.DeleteBlob(vhdContainer, vhdBlob); err != nil {
+			return err
+		}
 	}
 
-	log.Infof("waiting for nic deletion: %s/%s", resourceGroup, nicName)
-	if nicErr := <-nicErrChan; nicErr != nil {
-		return nicErr
+	for i, nicErrChan := range nicErrChans {
+		logger.Infof("waiting for nic deletion: %s/%s", resourceGroup, nicNames[i])
+		if nicErr := <-nicErrChan; nicErr != nil {
+			return nicErr
+		}
+	}
+
+	for i, diskErrChan := range diskErrChans {
+		logger.Infof("waiting for managed disk deletion: %s/%s", managedDisks[i].resourceGroup, managedDisks[i].name)
+		if diskErr := <-diskErrChan; diskErr != nil {
+			return diskErr
+		}
 	}
 
 	return nil
 }
+
+// getManagedDisk returns the managed disk referenced by a VM of resourceGroup, using the name
+// of the disk in the storage profile when the reference has no identifier
+func getManagedDisk(resourceGroup string, params *compute.ManagedDiskParameters, diskName *string) (managedDisk, error) {
+	if params.ID == nil {
+		if diskName == nil {
+			return managedDisk{}, fmt.Errorf("managed disk has neither an identifier nor a name")
+		}
+		return managedDisk{resourceGroup: resourceGroup, name: *diskName}, nil
+	}
+
+	name, err := armhelpers.ResourceName(*params.ID)
+	if err != nil {
+		return managedDisk{}, err
+	}
+	diskResourceGroup, err := armhelpers.ResourceGroupName(*params.ID)
+	if err != nil {
+		return managedDisk{}, err
+	}
+	return managedDisk{resourceGroup: diskResourceGroup, name: name}, nil
+}
@@ -0,0 +1,118 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/Sirupsen/logrus"
+)
+
+const testResourceGroup = "testrg"
+
+var testLogger = logrus.NewEntry(logrus.New())
+
+func resourceID(resourceType, name string) string {
+	return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/%s/providers/%s/%s", testResourceGroup, resourceType, name)
+}
+
+// newTestVM returns a VM with a NIC, an OS disk and an etcd data disk stored as blobs, or managed disks
+func newTestVM(name string, tags map[string]*string, managedDisks bool) compute.VirtualMachine {
+	osDisk := &compute.OSDisk{Name: to.StringPtr(name + "-osdisk")}
+	dataDisks := []compute.DataDisk{}
+	if managedDisks {
+		osDisk.ManagedDisk = &compute.ManagedDiskParameters{ID: to.StringPtr(resourceID("Microsoft.Compute/disks", name+"-osdisk"))}
+		dataDisks = append(dataDisks, compute.DataDisk{
+			Name:        to.StringPtr(name + "-datadisk0"),
+			ManagedDisk: &compute.ManagedDiskParameters{ID: to.StringPtr(resourceID("Microsoft.Compute/disks", name+"-datadisk0"))},
+		})
+	} else {
+		osDisk.Vhd = &compute.VirtualHardDisk{URI: to.StringPtr(fmt.Sprintf("https://teststorage.blob.core.windows.net/osdisk/%s-osdisk.vhd", name))}
+		dataDisks = append(dataDisks, compute.DataDisk{
+			Name: to.StringPtr(name + "-etcddisk"),
+			Vhd:  &compute.VirtualHardDisk{URI: to.StringPtr(fmt.Sprintf("https://teststorage.blob.core.windows.net/vhds/%s-etcddisk.vhd", name))},
+		})
+	}
+
+	return compute.VirtualMachine{
+		Name: to.StringPtr(name),
+		Tags: &tags,
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			StorageProfile: &compute.StorageProfile{
+				OsDisk:    osDisk,
+				DataDisks: &dataDisks,
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: &[]compute.NetworkInterfaceReference{
+					{ID: to.StringPtr(resourceID("Microsoft.Network/networkInterfaces", name+"-nic-0"))},
+					{ID: to.StringPtr(resourceID("Microsoft.Network/networkInterfaces", name+"-nic-1"))},
+				},
+			},
+		},
+	}
+}
+
+func TestCleanDeleteVirtualMachine(t *testing.T) {
+	client := armhelpers.NewFakeACSEngineClient()
+	client.AddVirtualMachine(testResourceGroup, newTestVM("k8s-master-22551669-0", nil, false))
+
+	if err := CleanDeleteVirtualMachine(context.Background(), testLogger, client, testResourceGroup, "k8s-master-22551669-0"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rg := client.ResourceGroups[testResourceGroup]
+	if len(rg.VirtualMachines) != 0 {
+		t.Fatalf("expected the VM to be deleted")
+	}
+	if len(rg.NetworkInterfaces) != 0 {
+		t.Fatalf("expected all the NICs to be deleted, remaining: %v", rg.NetworkInterfaces)
+	}
+	blobs := rg.StorageAccounts["teststorage"].Blobs
+	if blobs["osdisk/k8s-master-22551669-0-osdisk.vhd"] {
+		t.Fatalf("expected the OS disk blob to be deleted")
+	}
+	if !blobs["vhds/k8s-master-22551669-0-etcddisk.vhd"] {
+		t.Fatalf("expected the etcd disk blob to be preserved")
+	}
+}
+
+func TestCleanDeleteVirtualMachineManagedDisks(t *testing.T) {
+	client := armhelpers.NewFakeACSEngineClient()
+	client.AddVirtualMachine(testResourceGroup, newTestVM("k8s-agentpool1-22551669-0", nil, true))
+
+	if err := CleanDeleteVirtualMachine(context.Background(), testLogger, client, testResourceGroup, "k8s-agentpool1-22551669-0"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rg := client.ResourceGroups[testResourceGroup]
+	if len(rg.VirtualMachines) != 0 || len(rg.NetworkInterfaces) != 0 {
+		t.Fatalf("expected the VM and its NICs to be deleted")
+	}
+	if len(rg.ManagedDisks) != 0 {
+		t.Fatalf("expected the managed disks to be deleted, remaining: %v", rg.ManagedDisks)
+	}
+	if client.CallCount("DeleteManagedDisk") != 2 {
+		t.Fatalf("expected 2 managed disk deletions, got %d", client.CallCount("DeleteManagedDisk"))
+	}
+}
+
+func TestCleanDeleteVirtualMachineFailure(t *testing.T) {
+	client := armhelpers.NewFakeACSEngineClient()
+	client.AddVirtualMachine(testResourceGroup, newTestVM("k8s-agentpool1-22551669-0", nil, true))
+	client.Failures["DeleteVirtualMachine"] = fmt.Errorf("injected failure")
+
+	if err := CleanDeleteVirtualMachine(context.Background(), testLogger, client, testResourceGroup, "k8s-agentpool1-22551669-0"); err == nil {
+		t.Fatalf("expected the injected failure to be returned")
+	}
+
+	rg := client.ResourceGroups[testResourceGroup]
+	if len(rg.VirtualMachines) != 1 || len(rg.NetworkInterfaces) != 2 || len(rg.ManagedDisks) != 2 {
+		t.Fatalf("expected no resource to be deleted when the VM deletion fails")
+	}
+	if client.CallCount("DeleteNetworkInterface") != 0 || client.CallCount("DeleteManagedDisk") != 0 {
+		t.Fatalf("expected no NIC or disk deletion to be attempted")
+	}
+}
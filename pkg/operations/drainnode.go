@@ -0,0 +1,111 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+	"github.com/Sirupsen/logrus"
+)
+
+// DefaultDrainTimeout is how long the pods of a node have to be evicted before the node is deleted
+const DefaultDrainTimeout = 10 * time.Minute
+
+// DrainNode cordons the node so that no new pod is scheduled on it, then evicts its pods so
+// that they are rescheduled on other nodes, and waits until they are gone.
+// Evictions disallowed by a PodDisruptionBudget are retried until the timeout expires.
+// A zero gracePeriod lets each pod terminate within its own termination grace period, a positive
+// one is rounded up to whole seconds.
+// Mirror pods and DaemonSet pods are left on the node, as they cannot be rescheduled elsewhere.
+func DrainNode(ctx context.Context, logger *logrus.Entry, client kubernetes.Client, nodeName string, gracePeriod, timeout time.Duration) error {
+	logger.Infoln(fmt.Sprintf("Cordoning node: %s", nodeName))
+	if err := client.SetNodeUnschedulable(nodeName, true); err != nil {
+		if kubernetes.IsNotFound(err) {
+			logger.Infoln(fmt.Sprintf("Node %s is not registered, nothing to drain", nodeName))
+			return nil
+		}
+		return fmt.Errorf("failed to cordon node %s: %s", nodeName, err.Error())
+	}
+
+	pods, err := client.ListPods(nodeName)
+	if err != nil {
+		return fmt.Errorf("failed to list the pods of node %s: %s", nodeName, err.Error())
+	}
+
+	podsToEvict := []kubernetes.Pod{}
+	for _, pod := range pods {
+		if pod.IsMirrorPod() || pod.IsDaemonSetPod() || pod.IsTerminated() {
+			continue
+		}
+		podsToEvict = append(podsToEvict, pod)
+	}
+	if len(podsToEvict) == 0 {
+		return nil
+	}
+
+	gracePeriodSeconds := evictionGracePeriodSeconds(gracePeriod)
+
+	deadline := time.Now().Add(timeout)
+	remaining := podsToEvict
+	err = waitFor(ctx, logger, timeout, fmt.Sprintf("the pods of node %s to be evicted", nodeName), func() (bool, string) {
+		blocked := []kubernetes.Pod{}
+		reasons := []string{}
+		for i := range remaining {
+			pod := &remaining[i]
+			err := client.EvictPod(pod, gracePeriodSeconds)
+			if err == nil || kubernetes.IsNotFound(err) {
+				logger.Infoln(fmt.Sprintf("Evicted pod: %s/%s", pod.Metadata.Namespace, pod.Metadata.Name))
+				continue
+			}
+			blocked = append(blocked, *pod)
+			if kubernetes.IsTooManyRequests(err) {
+				reasons = append(reasons, fmt.Sprintf("eviction of %s/%s is disallowed by a disruption budget", pod.Metadata.Namespace, pod.Metadata.Name))
+			} else {
+				reasons = append(reasons, fmt.Sprintf("eviction of %s/%s failed: %s", pod.Metadata.Namespace, pod.Metadata.Name, err.Error()))
+			}
+		}
+		remaining = blocked
+		return len(remaining) == 0, strings.Join(reasons, ", ")
+	})
+	if err != nil {
+		return err
+	}
+
+	return waitFor(ctx, logger, deadline.Sub(time.Now()), fmt.Sprintf("the evicted pods of node %s to terminate", nodeName), func() (bool, string) {
+		for i := range podsToEvict {
+			evicted := &podsToEvict[i]
+			pod, err := client.GetPod(evicted.Metadata.Namespace, evicted.Metadata.Name)
+			if err != nil {
+				if kubernetes.IsNotFound(err) {
+					continue
+				}
+				return false, err.Error()
+			}
+			// a pod recreated with the same name, by a StatefulSet for example, is a different pod
+			if pod.Metadata.UID == evicted.Metadata.UID && pod.Spec.NodeName == nodeName {
+				return false, fmt.Sprintf("pod %s/%s is still terminating", pod.Metadata.Namespace, pod.Metadata.Name)
+			}
+		}
+		return true, ""
+	})
+}
+
+// UncordonNode makes the node schedulable again, once it has been replaced by a node running the target version
+func UncordonNode(logger *logrus.Entry, client kubernetes.Client, nodeName string) error {
+	logger.Infoln(fmt.Sprintf("Uncordoning node: %s", nodeName))
+	if err := client.SetNodeUnschedulable(nodeName, false); err != nil {
+		return fmt.Errorf("failed to uncordon node %s: %s", nodeName, err.Error())
+	}
+	return nil
+}
+
+// evictionGracePeriodSeconds returns the grace period of the evictions, -1 for the grace period of each pod.
+// The grace period is rounded up, as a zero grace period would delete the pods immediately.
+func evictionGracePeriodSeconds(gracePeriod time.Duration) int64 {
+	if gracePeriod <= 0 {
+		return -1
+	}
+	return int64((gracePeriod + time.Second - 1) / time.Second)
+}
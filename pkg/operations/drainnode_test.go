@@ -0,0 +1,91 @@
+package operations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+)
+
+func newPod(name string) kubernetes.Pod {
+	pod := kubernetes.Pod{}
+	pod.Metadata.Name = name
+	pod.Metadata.Namespace = "default"
+	pod.Metadata.UID = name + "-uid"
+	pod.Spec.NodeName = "k8s-agentpool1-22551669-0"
+	pod.Status.Phase = "Running"
+	return pod
+}
+
+func TestDrainNode(t *testing.T) {
+	defer setValidationPollInterval(time.Millisecond)()
+
+	controller := true
+	daemonSetPod := newPod("kube-proxy-1")
+	daemonSetPod.Metadata.OwnerReferences = []kubernetes.OwnerReference{{Kind: "DaemonSet", Name: "kube-proxy", Controller: &controller}}
+	mirrorPod := newPod("static-1")
+	mirrorPod.Metadata.Annotations = map[string]string{kubernetes.MirrorPodAnnotation: "hash"}
+
+	s := &fakeAPIServer{
+		pods:             []kubernetes.Pod{newPod("web-1"), daemonSetPod, mirrorPod, newPod("web-2")},
+		blockedEvictions: 2,
+	}
+	client, closeServer := newFakeKubernetesClient(t, s)
+	defer closeServer()
+
+	if err := DrainNode(context.Background(), testLogger, client, "k8s-agentpool1-22551669-0", 0, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !s.unschedulable {
+		t.Fatalf("expected the node to be cordoned")
+	}
+	if len(s.evictions) != 2 || s.evictions[0] != "web-1" || s.evictions[1] != "web-2" {
+		t.Fatalf("expected web-1 and web-2 to be evicted, evicted: %v", s.evictions)
+	}
+	if len(s.pods) != 2 {
+		t.Fatalf("expected the DaemonSet and mirror pods to stay on the node, remaining pods: %d", len(s.pods))
+	}
+
+	if err := UncordonNode(testLogger, client, "k8s-agentpool1-22551669-0"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.unschedulable {
+		t.Fatalf("expected the node to be uncordoned")
+	}
+}
+
+func TestDrainNodeBlockedByDisruptionBudget(t *testing.T) {
+	defer setValidationPollInterval(time.Millisecond)()
+
+	s := &fakeAPIServer{
+		pods:             []kubernetes.Pod{newPod("web-1")},
+		blockedEvictions: 1000000,
+	}
+	client, closeServer := newFakeKubernetesClient(t, s)
+	defer closeServer()
+
+	if err := DrainNode(context.Background(), testLogger, client, "k8s-agentpool1-22551669-0", 30*time.Second, 50*time.Millisecond); err == nil {
+		t.Fatalf("expected a timeout error")
+	}
+	if len(s.evictions) != 0 {
+		t.Fatalf("expected no pod to be evicted, evicted: %v", s.evictions)
+	}
+}
+
+func TestEvictionGracePeriodSeconds(t *testing.T) {
+	for _, test := range []struct {
+		gracePeriod time.Duration
+		expected    int64
+	}{
+		{0, -1},
+		{time.Millisecond, 1},
+		{time.Second, 1},
+		{1500 * time.Millisecond, 2},
+		{30 * time.Second, 30},
+	} {
+		if seconds := evictionGracePeriodSeconds(test.gracePeriod); seconds != test.expected {
+			t.Errorf("expected a grace period of %s to be %d seconds, got %d", test.gracePeriod, test.expected, seconds)
+		}
+	}
+}
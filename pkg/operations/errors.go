@@ -0,0 +1,37 @@
+package operations
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeoutError is returned when the cluster does not reach the expected state before the timeout expires
+type TimeoutError struct {
+	// Description is what was waited for, such as "etcd members to be healthy"
+	Description string
+	Timeout     time.Duration
+	// Reason is why the last check failed
+	Reason string
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s: %s", e.Timeout, e.Description, e.Reason)
+}
+
+// IsTimeout returns true if err is a TimeoutError
+func IsTimeout(err error) bool {
+	_, ok := err.(*TimeoutError)
+	return ok
+}
+
+// UpgradeNodeError is returned when a step of the upgrade of a node fails. The upgrade
+// can be resumed from that step with the checkpoint.
+type UpgradeNodeError struct {
+	VMName string
+	Step   UpgradeStep
+	Err    error
+}
+
+func (e *UpgradeNodeError) Error() string {
+	return fmt.Sprintf("%s failed for VM %s: %s", e.Step, e.VMName, e.Err.Error())
+}
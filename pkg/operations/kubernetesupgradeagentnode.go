@@ -0,0 +1,87 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+	"github.com/Sirupsen/logrus"
+)
+
+// Compiler to verify QueueMessageProcessor implements OperationsProcessor
+var _ UpgradeNode = &UpgradeAgentNode{}
+
+// UpgradeAgentNode upgrades a Kubernetes agent node to the target version
+type UpgradeAgentNode struct {
+	// TemplateMap must be normalized to only contain the VMs of AgentPoolName
+	TemplateMap             map[string]interface{}
+	ParametersMap           map[string]interface{}
+	UpgradeContainerService *api.ContainerService
+	AgentPoolName           string
+	ResourceGroup           string
+	Client                  armhelpers.ACSEngineClient
+	KubernetesClient        kubernetes.Client
+	ValidationTimeout       time.Duration
+	DrainTimeout            time.Duration
+	EvictionGracePeriod     time.Duration
+	Logger                  *logrus.Entry
+}
+
+// DeleteNode takes state/resources of the master/agent node from ListNodeResources
+// backs up/preserves state as needed by a specific version of Kubernetes and then deletes
+// the node
+func (kan *UpgradeAgentNode) DeleteNode(ctx context.Context, vmName *string) error {
+	if kan.KubernetesClient != nil {
+		if err := DrainNode(ctx, kan.Logger, kan.KubernetesClient, *vmName, kan.EvictionGracePeriod, kan.DrainTimeout); err != nil {
+			return err
+		}
+	} else {
+		kan.Logger.Warnln(fmt.Sprintf("No Kubernetes client, deleting agent node %s without draining it", *vmName))
+	}
+
+	return CleanDeleteVirtualMachine(ctx, kan.Logger, kan.Client, kan.ResourceGroup, *vmName)
+}
+
+// CreateNode creates a new master/agent node with the targeted version of Kubernetes
+func (kan *UpgradeAgentNode) CreateNode(ctx context.Context, agentOffset int) error {
+	// only deploy the VM at agentOffset, so the node comes back with the same name
+	kan.ParametersMap[fmt.Sprintf("%sOffset", kan.AgentPoolName)] = map[string]interface{}{
+		"value": agentOffset,
+	}
+	kan.ParametersMap[fmt.Sprintf("%sCount", kan.AgentPoolName)] = map[string]interface{}{
+		"value": agentOffset + 1,
+	}
+	kan.Logger.Infoln(fmt.Sprintf("Agent pool: %s, offset: %d", kan.AgentPoolName, agentOffset))
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	deploymentSuffix := random.Int31()
+
+	_, err := kan.Client.DeployTemplate(
+		ctx,
+		kan.ResourceGroup,
+		fmt.Sprintf("%s-%d", kan.ResourceGroup, deploymentSuffix),
+		kan.TemplateMap,
+		kan.ParametersMap)
+
+	return err
+}
+
+// Validate will verify the that master/agent node has been upgraded as expected.
+func (kan *UpgradeAgentNode) Validate(ctx context.Context, vmName *string) error {
+	if kan.KubernetesClient == nil {
+		kan.Logger.Warnln(fmt.Sprintf("No Kubernetes client, skipping validation of agent node: %s", *vmName))
+		return nil
+	}
+
+	version := kan.UpgradeContainerService.Properties.OrchestratorProfile.OrchestratorVersion
+	if err := WaitForNodeReady(ctx, kan.Logger, kan.KubernetesClient, *vmName, kubeletVersion(version), kan.ValidationTimeout); err != nil {
+		return err
+	}
+
+	// the node object outlives the VM it was cordoned on, so the replacement comes back cordoned
+	return UncordonNode(kan.Logger, kan.KubernetesClient, *vmName)
+}
@@ -0,0 +1,101 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+	"github.com/Sirupsen/logrus"
+)
+
+// Compiler to verify QueueMessageProcessor implements OperationsProcessor
+var _ UpgradeNode = &UpgradeMasterNode{}
+
+// UpgradeMasterNode upgrades a Kubernetes master node to the target version
+type UpgradeMasterNode struct {
+	TemplateMap             map[string]interface{}
+	ParametersMap           map[string]interface{}
+	UpgradeContainerService *api.ContainerService
+	ResourceGroup           string
+	Client                  armhelpers.ACSEngineClient
+	KubernetesClient        kubernetes.Client
+	ValidationTimeout       time.Duration
+	Logger                  *logrus.Entry
+}
+
+// DeleteNode takes state/resources of the master/agent node from ListNodeResources
+// backs up/preserves state as needed by a specific version of Kubernetes and then deletes
+// the node
+func (kmn *UpgradeMasterNode) DeleteNode(ctx context.Context, vmName *string) error {
+	return CleanDeleteVirtualMachine(ctx, kmn.Logger, kmn.Client, kmn.ResourceGroup, *vmName)
+}
+
+// CreateNode creates a new master/agent node with the targeted version of Kubernetes
+func (kmn *UpgradeMasterNode) CreateNode(ctx context.Context, masterOffset int) error {
+	templateVariables := kmn.TemplateMap["variables"].(map[string]interface{})
+
+	// the masters from masterOffset to masterCount-1 are deployed, so the node comes back with the same name.
+	// masterCount is left as is, the etcd initial cluster of the masters is derived from it. The masters are
+	// upgraded from the highest offset down, so the masters above masterOffset are already upgraded and their
+	// redeployment leaves them unchanged.
+	templateVariables["masterOffset"] = masterOffset
+	kmn.Logger.Infoln(fmt.Sprintf("Master offset: %v", masterOffset))
+
+	if err := acsengine.NormalizeResourcesForK8sMasterUpgrade(kmn.Logger, kmn.TemplateMap); err != nil {
+		return err
+	}
+
+	// ***********Save master update template*************
+	updatedTemplateJSON, _ := json.Marshal(kmn.TemplateMap)
+	parametersJSON, _ := json.Marshal(kmn.ParametersMap)
+
+	templateapp, err := acsengine.PrettyPrintArmTemplate(string(updatedTemplateJSON))
+	if err != nil {
+		return fmt.Errorf("error pretty printing template: %s", err.Error())
+	}
+	parametersapp, e := acsengine.PrettyPrintJSON(string(parametersJSON))
+	if e != nil {
+		return fmt.Errorf("error pretty printing template parameters: %s", e.Error())
+	}
+	outputDirectory := path.Join("_output", kmn.UpgradeContainerService.Properties.MasterProfile.DNSPrefix, "Upgrade")
+	if err := acsengine.WriteArtifacts(kmn.UpgradeContainerService, "vlabs", templateapp, parametersapp, outputDirectory, false, false, false); err != nil {
+		return fmt.Errorf("error writing artifacts: %s", err.Error())
+	}
+	// ************************
+
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+	deploymentSuffix := random.Int31()
+
+	_, err = kmn.Client.DeployTemplate(
+		ctx,
+		kmn.ResourceGroup,
+		fmt.Sprintf("%s-%d", kmn.ResourceGroup, deploymentSuffix),
+		kmn.TemplateMap,
+		kmn.ParametersMap)
+
+	return err
+}
+
+// Validate will verify the that master/agent node has been upgraded as expected.
+// A master must also have rejoined etcd before the next one is taken down, so that
+// the etcd cluster never loses quorum.
+func (kmn *UpgradeMasterNode) Validate(ctx context.Context, vmName *string) error {
+	if kmn.KubernetesClient == nil {
+		kmn.Logger.Warnln(fmt.Sprintf("No Kubernetes client, skipping validation of master node: %s", *vmName))
+		return nil
+	}
+
+	version := kmn.UpgradeContainerService.Properties.OrchestratorProfile.OrchestratorVersion
+	if err := WaitForNodeReady(ctx, kmn.Logger, kmn.KubernetesClient, *vmName, kubeletVersion(version), kmn.ValidationTimeout); err != nil {
+		return err
+	}
+	masterCount := kmn.UpgradeContainerService.Properties.MasterProfile.Count
+	return WaitForEtcdHealthy(ctx, kmn.Logger, kmn.KubernetesClient, *vmName, masterCount, kmn.ValidationTimeout)
+}
@@ -0,0 +1,270 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Sirupsen/logrus"
+)
+
+// Compiler to verify QueueMessageProcessor implements OperationsProcessor
+var _ UpgradeWorkFlow = &KubernetesUpgrader{}
+
+// KubernetesUpgrader upgrades a Kubernetes cluster to the version of the upgrade model,
+// along any of the upgrade paths registered for Kubernetes
+type KubernetesUpgrader struct {
+	ClusterTopology
+	Client armhelpers.ACSEngineClient
+
+	UpgradeModel *api.UpgradeContainerService
+	Checkpoint   *UpgradeCheckpoint
+
+	KubernetesClient      kubernetes.Client
+	NodeValidationTimeout time.Duration
+	DrainTimeout          time.Duration
+	EvictionGracePeriod   time.Duration
+	Logger                *logrus.Entry
+}
+
+func newKubernetesUpgrader(uc *UpgradeCluster) UpgradeWorkFlow {
+	return &KubernetesUpgrader{
+		ClusterTopology:       uc.ClusterTopology,
+		Client:                uc.Client,
+		UpgradeModel:          uc.UpgradeModel,
+		Checkpoint:            uc.Checkpoint,
+		KubernetesClient:      uc.KubernetesClient,
+		NodeValidationTimeout: uc.NodeValidationTimeout,
+		DrainTimeout:          uc.DrainTimeout,
+		EvictionGracePeriod:   uc.EvictionGracePeriod,
+		Logger:                uc.logger(),
+	}
+}
+
+// ClusterPreflightCheck does preflight check. The upgrade path is already checked when
+// the upgrader is looked up in the registry.
+func (ku *KubernetesUpgrader) ClusterPreflightCheck() error {
+	return nil
+}
+
+// RunUpgrade runs the upgrade pipeline, until the context is done
+func (ku *KubernetesUpgrader) RunUpgrade(ctx context.Context) error {
+	if err := ku.ClusterPreflightCheck(); err != nil {
+		return err
+	}
+
+	upgradeContainerService := ku.ClusterTopology.DataModel
+	upgradeContainerService.Properties.OrchestratorProfile.OrchestratorVersion = ku.UpgradeModel.OrchestratorProfile.OrchestratorVersion
+
+	templateGenerator, err := acsengine.InitializeTemplateGenerator(false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize template generator: %s", err.Error())
+	}
+
+	var templateJSON string
+	var parametersJSON string
+	if templateJSON, parametersJSON, _, err = templateGenerator.GenerateTemplate(upgradeContainerService); err != nil {
+		return fmt.Errorf("error generating upgrade template: %s", err.Error())
+	}
+
+	var template interface{}
+	var parameters interface{}
+	json.Unmarshal([]byte(templateJSON), &template)
+	json.Unmarshal([]byte(parametersJSON), &parameters)
+	templateMap := template.(map[string]interface{})
+	parametersMap := parameters.(map[string]interface{})
+
+	upgradeMasterNode := UpgradeMasterNode{}
+	upgradeMasterNode.TemplateMap = templateMap
+	upgradeMasterNode.ParametersMap = parametersMap
+	upgradeMasterNode.UpgradeContainerService = upgradeContainerService
+	upgradeMasterNode.ResourceGroup = ku.ClusterTopology.ResourceGroup
+	upgradeMasterNode.Client = ku.Client
+	upgradeMasterNode.KubernetesClient = ku.KubernetesClient
+	upgradeMasterNode.ValidationTimeout = ku.nodeValidationTimeout()
+	upgradeMasterNode.Logger = ku.Logger
+
+	// Sort by VM Name (e.g.: k8s-master-22551669-0) offset no. in descending order
+	sort.Sort(sort.Reverse(armhelpers.ByVMNameOffset(*ku.ClusterTopology.MasterVMs)))
+
+	for _, vm := range *ku.ClusterTopology.MasterVMs {
+		clusterVM, ok := IdentifyClusterVM(upgradeContainerService.Properties, vm)
+		if !ok {
+			return fmt.Errorf("failed to get the offset of master VM %s", *vm.Name)
+		}
+		masterOffset := clusterVM.NodeIndex
+
+		ku.Logger.Infoln(fmt.Sprintf("Upgrading Master VM: %s", *vm.Name))
+
+		// Shutdown and delete one master VM at a time while preserving the persistent disk backing etcd,
+		// then recreate it with the same offset
+		if err := ku.upgradeNode(ctx, &upgradeMasterNode, *vm.Name, masterOffset); err != nil {
+			return err
+		}
+	}
+
+	for _, agentPool := range upgradeContainerService.Properties.AgentPoolProfiles {
+		if err := ku.upgradeAgentPool(ctx, agentPool, templateJSON, parametersJSON); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upgradeAgentPool replaces the VMs of an agent pool one at a time, each new VM keeping the
+// offset, and so the name, of the VM it replaces
+func (ku *KubernetesUpgrader) upgradeAgentPool(ctx context.Context, agentPool *api.AgentPoolProfile, templateJSON, parametersJSON string) error {
+	upgradeContainerService := ku.ClusterTopology.DataModel
+
+	var agentVMs []compute.VirtualMachine
+	if poolVMs, ok := ku.ClusterTopology.AgentPools[agentPool.Name]; ok {
+		agentVMs = append(agentVMs, *poolVMs...)
+	}
+	if len(agentVMs) == 0 {
+		ku.Logger.Infoln(fmt.Sprintf("No VMs to upgrade in agent pool: %s", agentPool.Name))
+		return nil
+	}
+
+	if !agentPool.IsAvailabilitySets() {
+		return fmt.Errorf("Upgrade of agent pool %s is not supported for availability profile: %s", agentPool.Name, agentPool.AvailabilityProfile)
+	}
+
+	templateMap := map[string]interface{}{}
+	parametersMap := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(templateJSON), &templateMap); err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(parametersJSON), &parametersMap); err != nil {
+		return err
+	}
+
+	if err := acsengine.NormalizeResourcesForK8sAgentUpgrade(ku.Logger, templateMap, map[string]bool{agentPool.Name: true}); err != nil {
+		return err
+	}
+
+	upgradeAgentNode := UpgradeAgentNode{}
+	upgradeAgentNode.TemplateMap = templateMap
+	upgradeAgentNode.ParametersMap = parametersMap
+	upgradeAgentNode.UpgradeContainerService = upgradeContainerService
+	upgradeAgentNode.AgentPoolName = agentPool.Name
+	upgradeAgentNode.ResourceGroup = ku.ClusterTopology.ResourceGroup
+	upgradeAgentNode.Client = ku.Client
+	upgradeAgentNode.KubernetesClient = ku.KubernetesClient
+	upgradeAgentNode.ValidationTimeout = ku.nodeValidationTimeout()
+	upgradeAgentNode.DrainTimeout = ku.drainTimeout()
+	upgradeAgentNode.EvictionGracePeriod = ku.EvictionGracePeriod
+	upgradeAgentNode.Logger = ku.Logger
+
+	sort.Sort(armhelpers.ByVMNameOffset(agentVMs))
+
+	for _, vm := range agentVMs {
+		clusterVM, ok := IdentifyClusterVM(upgradeContainerService.Properties, vm)
+		if !ok {
+			return fmt.Errorf("failed to get the offset of agent VM %s", *vm.Name)
+		}
+		agentOffset := clusterVM.NodeIndex
+
+		ku.Logger.Infoln(fmt.Sprintf("Upgrading Agent VM: %s", *vm.Name))
+
+		if err := ku.upgradeNode(ctx, &upgradeAgentNode, *vm.Name, agentOffset); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upgradeNode deletes, recreates and validates a node, recording each step in the checkpoint.
+// Nodes the checkpoint records as upgraded are skipped, and the node the checkpoint records
+// as in progress restarts from the step that was interrupted. No step is started once the
+// context is done, so that the checkpoint records where to resume.
+func (ku *KubernetesUpgrader) upgradeNode(ctx context.Context, node UpgradeNode, vmName string, offset int) error {
+	if ku.Checkpoint.IsCompleted(vmName) {
+		ku.Logger.Infoln(fmt.Sprintf("Skipping VM %s, it has already been upgraded", vmName))
+		return nil
+	}
+
+	resumeStep := ku.Checkpoint.ResumeStep(vmName)
+	started := false
+	for _, step := range upgradeSteps {
+		if step == resumeStep {
+			started = true
+		}
+		if !started {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return &UpgradeNodeError{VMName: vmName, Step: step, Err: err}
+		}
+
+		if err := ku.Checkpoint.StartStep(vmName, step); err != nil {
+			return fmt.Errorf("failed to save upgrade checkpoint: %s", err.Error())
+		}
+
+		var err error
+		switch step {
+		case DeleteNodeStep:
+			err = node.DeleteNode(ctx, &vmName)
+		case CreateNodeStep:
+			err = node.CreateNode(ctx, offset)
+		case ValidateNodeStep:
+			err = node.Validate(ctx, &vmName)
+		}
+		if err != nil {
+			return &UpgradeNodeError{VMName: vmName, Step: step, Err: err}
+		}
+	}
+
+	if err := ku.Checkpoint.CompleteVM(vmName); err != nil {
+		return fmt.Errorf("failed to save upgrade checkpoint: %s", err.Error())
+	}
+	return nil
+}
+
+// Validate will run validation post upgrade
+func (ku *KubernetesUpgrader) Validate() error {
+	if ku.KubernetesClient == nil {
+		ku.Logger.Warnln("No Kubernetes client, skipping validation of the upgraded cluster")
+		return nil
+	}
+
+	nodes, err := ku.KubernetesClient.ListNodes()
+	if err != nil {
+		return fmt.Errorf("failed to list the nodes of the upgraded cluster: %s", err.Error())
+	}
+
+	kubeletVersion := kubeletVersion(ku.UpgradeModel.OrchestratorProfile.OrchestratorVersion)
+	for _, node := range nodes {
+		if !node.IsReady() {
+			return fmt.Errorf("node %s is not Ready after the upgrade", node.Metadata.Name)
+		}
+		if node.Status.NodeInfo.KubeletVersion != kubeletVersion {
+			return fmt.Errorf("node %s runs kubelet %s after the upgrade, expected %s",
+				node.Metadata.Name, node.Status.NodeInfo.KubeletVersion, kubeletVersion)
+		}
+	}
+
+	return nil
+}
+
+func (ku *KubernetesUpgrader) nodeValidationTimeout() time.Duration {
+	if ku.NodeValidationTimeout <= 0 {
+		return DefaultNodeValidationTimeout
+	}
+	return ku.NodeValidationTimeout
+}
+
+func (ku *KubernetesUpgrader) drainTimeout() time.Duration {
+	if ku.DrainTimeout <= 0 {
+		return DefaultDrainTimeout
+	}
+	return ku.DrainTimeout
+}
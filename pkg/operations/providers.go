@@ -0,0 +1,29 @@
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Sirupsen/logrus"
+)
+
+// ResourceProviders returns the resource providers the subscription must be registered to for the cluster to deploy
+func ResourceProviders(properties *api.Properties) []string {
+	providers := append([]string{}, armhelpers.RequiredResourceProviders...)
+
+	// the secrets installed on the VMs are read from key vaults
+	if (properties.LinuxProfile != nil && properties.LinuxProfile.HasSecrets()) ||
+		(properties.WindowsProfile != nil && properties.WindowsProfile.HasSecrets()) {
+		providers = append(providers, "Microsoft.KeyVault")
+	}
+
+	return providers
+}
+
+// EnsureResourceProviders registers the subscription to the resource providers the cluster needs,
+// and waits up to timeout for the registrations to complete, or until the context is done
+func EnsureResourceProviders(ctx context.Context, logger *logrus.Entry, client armhelpers.ACSEngineClient, properties *api.Properties, timeout time.Duration) error {
+	return armhelpers.EnsureResourceProvidersRegistered(ctx, logger, client, ResourceProviders(properties), timeout)
+}
@@ -0,0 +1,45 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+)
+
+func TestEnsureResourceProviders(t *testing.T) {
+	properties := &api.Properties{
+		LinuxProfile: &api.LinuxProfile{
+			Secrets: []api.KeyVaultSecrets{{SourceVault: &api.KeyVaultID{ID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/vault"}}},
+		},
+	}
+	if providers := strings.Join(ResourceProviders(properties), ","); !strings.Contains(providers, "Microsoft.KeyVault") {
+		t.Fatalf("expected Microsoft.KeyVault to be required when secrets are referenced, got %s", providers)
+	}
+
+	defer func(interval time.Duration) { armhelpers.ProviderRegistrationPollInterval = interval }(armhelpers.ProviderRegistrationPollInterval)
+	armhelpers.ProviderRegistrationPollInterval = time.Millisecond
+
+	client := armhelpers.NewFakeACSEngineClient()
+	client.Providers["Microsoft.Compute"] = "NotRegistered"
+	client.Providers["Microsoft.KeyVault"] = "NotRegistered"
+	client.Providers["Microsoft.ContainerService"] = "NotRegistered"
+
+	if err := EnsureResourceProviders(context.Background(), testLogger, client, properties, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if client.CallCount("RegisterProvider") != 2 {
+		t.Fatalf("expected 2 registrations, got %d", client.CallCount("RegisterProvider"))
+	}
+	for _, namespace := range []string{"Microsoft.Compute", "Microsoft.KeyVault"} {
+		if client.Providers[namespace] != "Registered" {
+			t.Fatalf("expected %s to be registered, is %s", namespace, client.Providers[namespace])
+		}
+	}
+	if client.Providers["Microsoft.ContainerService"] != "NotRegistered" {
+		t.Fatalf("expected only the required resource providers to be registered")
+	}
+}
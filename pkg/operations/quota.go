@@ -0,0 +1,229 @@
+package operations
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// totalCoresUsage is the name of the usage counting the cores of all the VM families in a region
+const totalCoresUsage = "cores"
+
+// CoreUsage compares the cores a cluster requires to the cores left in a quota
+type CoreUsage struct {
+	// Name is the name of the quota, a VM family such as standardDSv2Family, or cores for the regional total
+	Name string
+	// Required is the number of cores the cluster requires
+	Required int64
+	// Used is the number of cores the subscription already uses in the region
+	Used int64
+	// Limit is the quota, or -1 when the subscription has no quota for the VM family
+	Limit int64
+}
+
+// Available returns the number of cores left in the quota
+func (u CoreUsage) Available() int64 {
+	return u.Limit - u.Used
+}
+
+// Exceeded returns true when the quota cannot accommodate the required cores
+func (u CoreUsage) Exceeded() bool {
+	return u.Limit >= 0 && u.Required > u.Available()
+}
+
+// QuotaReport is the result of checking the quota and the VM sizes of a cluster in a region
+type QuotaReport struct {
+	Location string
+	// UnavailableSizes are the VM sizes of the cluster that cannot be deployed in the location, with the profiles using them
+	UnavailableSizes map[string][]string
+	// Families are the cores the cluster requires per VM family, sorted by name
+	Families []CoreUsage
+	// Total is the total of cores the cluster requires in the region
+	Total CoreUsage
+}
+
+// Err returns an error listing every VM size not available and every quota exceeded, or nil if the cluster fits
+func (r *QuotaReport) Err() error {
+	problems := []string{}
+
+	sizes := []string{}
+	for size := range r.UnavailableSizes {
+		sizes = append(sizes, size)
+	}
+	sort.Strings(sizes)
+	for _, size := range sizes {
+		problems = append(problems, fmt.Sprintf("VM size %s (%s) is not available in %s",
+			size, strings.Join(r.UnavailableSizes[size], ", "), r.Location))
+	}
+
+	for _, usage := range append(r.Families, r.Total) {
+		if usage.Exceeded() {
+			problems = append(problems, fmt.Sprintf("%s quota exceeded in %s: %d cores required, %d available (%d used of %d)",
+				usage.Name, r.Location, usage.Required, usage.Available(), usage.Used, usage.Limit))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the cluster cannot be deployed in %s:\n  %s", r.Location, strings.Join(problems, "\n  "))
+}
+
+// String returns the report as a table of the required, used and limit cores per quota
+func (r *QuotaReport) String() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%-24s %9s %9s %9s %9s\n", "QUOTA", "REQUIRED", "USED", "LIMIT", "STATUS")
+	for _, usage := range append(r.Families, r.Total) {
+		limit, status := strconv.FormatInt(usage.Limit, 10), "ok"
+		if usage.Limit < 0 {
+			limit, status = "-", "unknown"
+		} else if usage.Exceeded() {
+			status = "EXCEEDED"
+		}
+		fmt.Fprintf(&b, "%-24s %9d %9d %9s %9s\n", usage.Name, usage.Required, usage.Used, limit, status)
+	}
+	return b.String()
+}
+
+// CheckQuota checks that the VM sizes of the cluster are available in the location, and that the
+// regional quotas of the subscription can accommodate the cores of the cluster, per VM family and in total.
+// The returned error is only set when the check cannot be run, problems are reported by QuotaReport.Err.
+func CheckQuota(client armhelpers.ACSEngineClient, properties *api.Properties, location string) (*QuotaReport, error) {
+	sizes, err := client.ListVirtualMachineSizes(location)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the VM sizes of %s: %s", location, err.Error())
+	}
+	cores := map[string]int64{}
+	if sizes.Value != nil {
+		for _, size := range *sizes.Value {
+			cores[strings.ToLower(to.String(size.Name))] = int64(to.Int32(size.NumberOfCores))
+		}
+	}
+
+	usages, err := client.ListUsages(location)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the compute usages of %s: %s", location, err.Error())
+	}
+	report := &QuotaReport{
+		Location:         location,
+		UnavailableSizes: map[string][]string{},
+		Total:            CoreUsage{Name: totalCoresUsage, Limit: -1},
+	}
+	quotas := map[string]CoreUsage{}
+	if usages.Value != nil {
+		for _, usage := range *usages.Value {
+			if usage.Name == nil {
+				continue
+			}
+			quota := CoreUsage{
+				Name:  to.String(usage.Name.Value),
+				Used:  int64(to.Int32(usage.CurrentValue)),
+				Limit: -1,
+			}
+			if usage.Limit != nil {
+				quota.Limit = *usage.Limit
+			}
+			quotas[strings.ToLower(quota.Name)] = quota
+		}
+	}
+	if total, ok := quotas[totalCoresUsage]; ok {
+		report.Total = total
+	}
+
+	families := map[string]*CoreUsage{}
+	for _, pool := range clusterVMPools(properties) {
+		size := pool.vmSize
+		sizeCores, ok := cores[strings.ToLower(size)]
+		if !ok {
+			report.UnavailableSizes[size] = append(report.UnavailableSizes[size], pool.name)
+			continue
+		}
+
+		family := VMSizeFamily(size)
+		usage, ok := families[strings.ToLower(family)]
+		if !ok {
+			usage = &CoreUsage{Name: family, Limit: -1}
+			if quota, ok := quotas[strings.ToLower(family)]; ok {
+				usage.Name, usage.Used, usage.Limit = quota.Name, quota.Used, quota.Limit
+			}
+			families[strings.ToLower(family)] = usage
+		}
+		required := int64(pool.count) * sizeCores
+		usage.Required += required
+		report.Total.Required += required
+	}
+
+	for _, usage := range families {
+		report.Families = append(report.Families, *usage)
+	}
+	sort.Sort(coreUsagesByName(report.Families))
+
+	return report, nil
+}
+
+type vmPool struct {
+	name   string
+	count  int
+	vmSize string
+}
+
+// clusterVMPools returns the master and agent pools of the cluster
+func clusterVMPools(properties *api.Properties) []vmPool {
+	pools := []vmPool{}
+	if properties.MasterProfile != nil {
+		pools = append(pools, vmPool{"master", properties.MasterProfile.Count, properties.MasterProfile.VMSize})
+	}
+	for _, agentPool := range properties.AgentPoolProfiles {
+		pools = append(pools, vmPool{agentPool.Name, agentPool.Count, agentPool.VMSize})
+	}
+	return pools
+}
+
+type coreUsagesByName []CoreUsage
+
+func (u coreUsagesByName) Len() int           { return len(u) }
+func (u coreUsagesByName) Less(i, j int) bool { return u[i].Name < u[j].Name }
+func (u coreUsagesByName) Swap(i, j int)      { u[i], u[j] = u[j], u[i] }
+
+// vmSizeRegexp splits a VM size such as Standard_DS2_v2 or Standard_F8s into its tier, series, number, suffix and version
+var vmSizeRegexp = regexp.MustCompile(`^(?i:(standard|basic))_([A-Z]+)(\d+)([a-z]*)(?:_v(\d+))?$`)
+
+// constrainedCoresRegexp matches the active cores of the constrained sizes, such as the -8 of Standard_DS13-8_v2
+var constrainedCoresRegexp = regexp.MustCompile(`-\d+`)
+
+// VMSizeFamily returns the name of the quota of the VM family of the size, as listed by the compute usages of
+// a region: Standard_D2_v2 is in standardDv2Family, Standard_DS2_v2 and Standard_D2s_v2 are in standardDSv2Family
+func VMSizeFamily(vmSize string) string {
+	m := vmSizeRegexp.FindStringSubmatch(constrainedCoresRegexp.ReplaceAllString(vmSize, ""))
+	if m == nil {
+		return vmSize
+	}
+	tier, series, number, suffix, version := strings.ToLower(m[1]), m[2], m[3], m[4], m[5]
+
+	if tier == "basic" {
+		return fmt.Sprintf("basic%sFamily", series)
+	}
+	if series == "A" && version == "" {
+		// the original A sizes share two families
+		if n, _ := strconv.Atoi(number); n <= 7 {
+			return "standardA0_A7Family"
+		}
+		return "standardA8_A11Family"
+	}
+
+	// the s suffix denotes premium storage, a family of its own
+	if strings.Contains(suffix, "s") && !strings.HasSuffix(series, "S") {
+		series += "S"
+	}
+	if version != "" && version != "1" {
+		series += "v" + version
+	}
+	return fmt.Sprintf("standard%sFamily", series)
+}
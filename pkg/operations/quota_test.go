@@ -0,0 +1,96 @@
+package operations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestVMSizeFamily(t *testing.T) {
+	for size, expected := range map[string]string{
+		"Standard_D2_v2":     "standardDv2Family",
+		"Standard_DS2_v2":    "standardDSv2Family",
+		"Standard_DS13-4_v2": "standardDSv2Family",
+		"Standard_D4s_v3":    "standardDSv3Family",
+		"Standard_D2":        "standardDFamily",
+		"Standard_F8s":       "standardFSFamily",
+		"Standard_A2m_v2":    "standardAv2Family",
+		"Standard_A3":        "standardA0_A7Family",
+		"Standard_A9":        "standardA8_A11Family",
+		"Standard_NC6":       "standardNCFamily",
+		"Standard_GS2":       "standardGSFamily",
+		"Basic_A1":           "basicAFamily",
+	} {
+		if family := VMSizeFamily(size); family != expected {
+			t.Fatalf("incorrect family for %s. expected=%s actual=%s", size, expected, family)
+		}
+	}
+}
+
+func newQuotaTestClient() *armhelpers.FakeACSEngineClient {
+	client := armhelpers.NewFakeACSEngineClient()
+	client.VirtualMachineSizes["westus"] = []compute.VirtualMachineSize{
+		{Name: to.StringPtr("Standard_D2_v2"), NumberOfCores: to.Int32Ptr(2)},
+		{Name: to.StringPtr("Standard_DS4_v2"), NumberOfCores: to.Int32Ptr(8)},
+	}
+	client.Usages["westus"] = []compute.Usage{
+		{Name: &compute.UsageName{Value: to.StringPtr("cores")}, CurrentValue: to.Int32Ptr(10), Limit: to.Int64Ptr(100)},
+		{Name: &compute.UsageName{Value: to.StringPtr("standardDv2Family")}, CurrentValue: to.Int32Ptr(4), Limit: to.Int64Ptr(20)},
+		{Name: &compute.UsageName{Value: to.StringPtr("standardDSv2Family")}, CurrentValue: to.Int32Ptr(6), Limit: to.Int64Ptr(20)},
+	}
+	return client
+}
+
+func TestCheckQuota(t *testing.T) {
+	properties := &api.Properties{
+		MasterProfile: &api.MasterProfile{Count: 3, VMSize: "Standard_D2_v2"},
+		AgentPoolProfiles: []*api.AgentPoolProfile{
+			{Name: "agentpool1", Count: 2, VMSize: "Standard_DS4_v2"},
+			{Name: "agentpool2", Count: 1, VMSize: "Standard_D2_v2"},
+		},
+	}
+
+	report, err := CheckQuota(newQuotaTestClient(), properties, "westus")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if report.Total.Required != 24 {
+		t.Fatalf("incorrect total of required cores. expected=24 actual=%d", report.Total.Required)
+	}
+	if len(report.Families) != 2 || report.Families[0].Required != 16 || report.Families[1].Required != 8 {
+		t.Fatalf("incorrect required cores per family: %+v", report.Families)
+	}
+
+	err = report.Err()
+	if err == nil {
+		t.Fatalf("expected the standardDSv2Family quota to be exceeded")
+	}
+	if !strings.Contains(err.Error(), "standardDSv2Family quota exceeded in westus: 16 cores required, 14 available") {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if strings.Contains(err.Error(), "standardDv2Family") || strings.Contains(err.Error(), "cores quota") {
+		t.Fatalf("expected only the standardDSv2Family quota to be exceeded: %s", err)
+	}
+}
+
+func TestCheckQuotaUnavailableSize(t *testing.T) {
+	properties := &api.Properties{
+		MasterProfile: &api.MasterProfile{Count: 1, VMSize: "Standard_D2_v2"},
+		AgentPoolProfiles: []*api.AgentPoolProfile{
+			{Name: "gpu", Count: 1, VMSize: "Standard_NC6"},
+		},
+	}
+
+	report, err := CheckQuota(newQuotaTestClient(), properties, "westus")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = report.Err()
+	if err == nil || !strings.Contains(err.Error(), "VM size Standard_NC6 (gpu) is not available in westus") {
+		t.Fatalf("expected Standard_NC6 to be reported as not available, got: %v", err)
+	}
+}
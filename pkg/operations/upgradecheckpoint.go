@@ -0,0 +1,147 @@
+package operations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/Azure/acs-engine/pkg/api"
+)
+
+// UpgradeCheckpointFileName is the name of the file, in the deployment directory, that
+// records the progress of an upgrade
+const UpgradeCheckpointFileName = "upgrade.checkpoint.json"
+
+// UpgradeStep is a step of the upgrade of a single master or agent node
+type UpgradeStep string
+
+const (
+	// DeleteNodeStep is the step deleting the node running the current version
+	DeleteNodeStep UpgradeStep = "DeleteNode"
+	// CreateNodeStep is the step creating the node running the target version
+	CreateNodeStep UpgradeStep = "CreateNode"
+	// ValidateNodeStep is the step validating the node running the target version
+	ValidateNodeStep UpgradeStep = "ValidateNode"
+)
+
+// upgradeSteps lists the steps of a node upgrade in the order they are run
+var upgradeSteps = []UpgradeStep{DeleteNodeStep, CreateNodeStep, ValidateNodeStep}
+
+// UpgradeCheckpoint records which nodes an upgrade has already replaced, and the step it is
+// running on the current node, so that an interrupted upgrade can be resumed.
+// Every change is saved to disk before the corresponding step runs. A nil checkpoint records nothing.
+type UpgradeCheckpoint struct {
+	FromVersion   api.OrchestratorVersion `json:"fromVersion"`
+	TargetVersion api.OrchestratorVersion `json:"targetVersion"`
+	CompletedVMs  []string                `json:"completedVMs"`
+	CurrentVM     string                  `json:"currentVM,omitempty"`
+	CurrentStep   UpgradeStep             `json:"currentStep,omitempty"`
+
+	path string
+}
+
+// NewUpgradeCheckpoint returns an empty checkpoint for an upgrade from fromVersion to targetVersion,
+// which will be saved at the specified path
+func NewUpgradeCheckpoint(path string, fromVersion, targetVersion api.OrchestratorVersion) *UpgradeCheckpoint {
+	return &UpgradeCheckpoint{
+		FromVersion:   fromVersion,
+		TargetVersion: targetVersion,
+		CompletedVMs:  []string{},
+		path:          path,
+	}
+}
+
+// LoadUpgradeCheckpoint loads the checkpoint saved at the specified path
+func LoadUpgradeCheckpoint(path string) (*UpgradeCheckpoint, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading upgrade checkpoint %s: %s", path, err.Error())
+	}
+
+	checkpoint := &UpgradeCheckpoint{}
+	if err := json.Unmarshal(contents, checkpoint); err != nil {
+		return nil, fmt.Errorf("error parsing upgrade checkpoint %s: %s", path, err.Error())
+	}
+	checkpoint.path = path
+
+	return checkpoint, nil
+}
+
+// Save writes the checkpoint to disk
+func (c *UpgradeCheckpoint) Save() error {
+	if c == nil {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a crash never leaves a truncated checkpoint
+	tmpPath := c.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, c.path)
+}
+
+// Remove deletes the checkpoint from disk, once the upgrade has completed
+func (c *UpgradeCheckpoint) Remove() error {
+	if c == nil {
+		return nil
+	}
+
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// IsCompleted returns true if the specified VM has already been upgraded
+func (c *UpgradeCheckpoint) IsCompleted(vmName string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, completedVM := range c.CompletedVMs {
+		if completedVM == vmName {
+			return true
+		}
+	}
+	return false
+}
+
+// ResumeStep returns the step the upgrade of the specified VM must start from
+func (c *UpgradeCheckpoint) ResumeStep(vmName string) UpgradeStep {
+	if c == nil || c.CurrentVM != vmName || c.CurrentStep == "" {
+		return DeleteNodeStep
+	}
+	return c.CurrentStep
+}
+
+// StartStep records that the specified step of the upgrade of vmName is about to run
+func (c *UpgradeCheckpoint) StartStep(vmName string, step UpgradeStep) error {
+	if c == nil {
+		return nil
+	}
+
+	c.CurrentVM = vmName
+	c.CurrentStep = step
+	return c.Save()
+}
+
+// CompleteVM records that the specified VM has been upgraded
+func (c *UpgradeCheckpoint) CompleteVM(vmName string) error {
+	if c == nil {
+		return nil
+	}
+
+	if !c.IsCompleted(vmName) {
+		c.CompletedVMs = append(c.CompletedVMs, vmName)
+	}
+	c.CurrentVM = ""
+	c.CurrentStep = ""
+	return c.Save()
+}
@@ -0,0 +1,51 @@
+package operations
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/api"
+)
+
+func Test_UpgradeCheckpoint_Resume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "acs-engine-checkpoint")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	checkpointPath := path.Join(dir, UpgradeCheckpointFileName)
+	checkpoint := NewUpgradeCheckpoint(checkpointPath, api.Kubernetes153, api.Kubernetes162)
+	if err := checkpoint.CompleteVM("k8s-master-12345678-2"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := checkpoint.StartStep("k8s-master-12345678-1", CreateNodeStep); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	loaded, err := LoadUpgradeCheckpoint(checkpointPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if loaded.TargetVersion != api.Kubernetes162 {
+		t.Fatalf("incorrect target version. expected=%s actual=%s", api.Kubernetes162, loaded.TargetVersion)
+	}
+	if !loaded.IsCompleted("k8s-master-12345678-2") {
+		t.Fatalf("expected k8s-master-12345678-2 to be completed")
+	}
+	if step := loaded.ResumeStep("k8s-master-12345678-1"); step != CreateNodeStep {
+		t.Fatalf("incorrect resume step. expected=%s actual=%s", CreateNodeStep, step)
+	}
+	if step := loaded.ResumeStep("k8s-master-12345678-0"); step != DeleteNodeStep {
+		t.Fatalf("incorrect resume step. expected=%s actual=%s", DeleteNodeStep, step)
+	}
+
+	if err := loaded.Remove(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := os.Stat(checkpointPath); !os.IsNotExist(err) {
+		t.Fatalf("expected the checkpoint to be removed")
+	}
+}
@@ -1,14 +1,15 @@
 package operations
 
 import (
+	"context"
 	"fmt"
-
-	"strings"
+	"time"
 
 	"github.com/Azure/acs-engine/pkg/api"
 	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
-	log "github.com/Sirupsen/logrus"
+	"github.com/Sirupsen/logrus"
 	"github.com/satori/go.uuid"
 )
 
@@ -19,7 +20,20 @@ type ClusterTopology struct {
 	ResourceGroup string
 
 	MasterVMs *[]compute.VirtualMachine
-	AgentVMs  *[]compute.VirtualMachine
+	// AgentPools are the VMs of the agent pools, by agent pool name
+	AgentPools map[string]*[]compute.VirtualMachine
+}
+
+// addVM adds a VM to the masters or to its agent pool
+func (ct *ClusterTopology) addVM(clusterVM ClusterVM, vm compute.VirtualMachine) {
+	if clusterVM.Role == MasterRole {
+		*ct.MasterVMs = append(*ct.MasterVMs, vm)
+		return
+	}
+	if _, ok := ct.AgentPools[clusterVM.PoolName]; !ok {
+		ct.AgentPools[clusterVM.PoolName] = &[]compute.VirtualMachine{}
+	}
+	*ct.AgentPools[clusterVM.PoolName] = append(*ct.AgentPools[clusterVM.PoolName], vm)
 }
 
 // UpgradeCluster upgrades a cluster with Orchestrator version X
@@ -30,12 +44,35 @@ type UpgradeCluster struct {
 	Client armhelpers.ACSEngineClient
 
 	UpgradeModel *api.UpgradeContainerService
+
+	// Checkpoint, when set, records the progress of the upgrade. A checkpoint
+	// loaded from a previous run resumes the upgrade where that run stopped.
+	Checkpoint *UpgradeCheckpoint
+
+	// KubernetesClient, when set, is used to verify that each replaced node is healthy
+	// before moving on to the next one
+	KubernetesClient kubernetes.Client
+
+	// NodeValidationTimeout is how long a replaced node has to become healthy,
+	// DefaultNodeValidationTimeout when not set
+	NodeValidationTimeout time.Duration
+
+	// DrainTimeout is how long the pods of an agent node have to be evicted before the
+	// node is deleted, DefaultDrainTimeout when not set
+	DrainTimeout time.Duration
+
+	// EvictionGracePeriod overrides the termination grace period of the evicted pods when set
+	EvictionGracePeriod time.Duration
+
+	// Logger receives the progress of the upgrade, the standard logrus logger when not set
+	Logger *logrus.Entry
 }
 
 // UpgradeCluster runs the workflow to upgrade a Kubernetes cluster.
 // UpgradeContainerService contains target state of the cluster that
-// the operation will drive towards.
-func (uc *UpgradeCluster) UpgradeCluster(subscriptionID uuid.UUID, resourceGroup string,
+// the operation will drive towards. The upgrade stops between two steps once the context is done,
+// and can be resumed with the checkpoint.
+func (uc *UpgradeCluster) UpgradeCluster(ctx context.Context, subscriptionID uuid.UUID, resourceGroup string,
 	cs *api.ContainerService, ucs *api.UpgradeContainerService) error {
 
 	// TODO: remove this when we fix cloud-init properly
@@ -44,28 +81,29 @@ func (uc *UpgradeCluster) UpgradeCluster(subscriptionID uuid.UUID, resourceGroup
 	uc.ClusterTopology = ClusterTopology{}
 	uc.ResourceGroup = resourceGroup
 	uc.DataModel = cs
+	uc.UpgradeModel = ucs
 	uc.MasterVMs = &[]compute.VirtualMachine{}
-	uc.AgentVMs = &[]compute.VirtualMachine{}
+	uc.AgentPools = map[string]*[]compute.VirtualMachine{}
 
 	if err := uc.getUpgradableResources(subscriptionID, resourceGroup); err != nil {
 		return fmt.Errorf("Error while querying ARM for resources: %+v", err)
 	}
 
-	switch ucs.OrchestratorProfile.OrchestratorVersion {
-	case api.Kubernetes162:
-		log.Infoln(fmt.Sprintf("Upgrading to Kubernetes 1.6.2"))
-		upgrader := Kubernetes162upgrader{}
-		upgrader.ClusterTopology = uc.ClusterTopology
-		upgrader.Client = uc.Client
-		if err := upgrader.RunUpgrade(); err != nil {
-			return err
-		}
-	default:
-		return fmt.Errorf("Upgrade to Kubernetes 1.6.2 is not supported from version: %s",
-			uc.DataModel.Properties.OrchestratorProfile.OrchestratorVersion)
+	upgrader, err := getUpgradeWorkFlow(uc)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	uc.logger().Infoln(fmt.Sprintf("Upgrading to %s %s", ucs.OrchestratorProfile.OrchestratorType, ucs.OrchestratorProfile.OrchestratorVersion))
+	if err := upgrader.RunUpgrade(ctx); err != nil {
+		return err
+	}
+
+	if err := upgrader.Validate(); err != nil {
+		return err
+	}
+
+	return uc.Checkpoint.Remove()
 }
 
 func (uc *UpgradeCluster) getUpgradableResources(subscriptionID uuid.UUID, resourceGroup string) error {
@@ -74,25 +112,54 @@ func (uc *UpgradeCluster) getUpgradableResources(subscriptionID uuid.UUID, resou
 		return err
 	}
 
-	orchestratorTypeVersion := fmt.Sprintf("%s:%s", uc.DataModel.Properties.OrchestratorProfile.OrchestratorType,
-		uc.DataModel.Properties.OrchestratorProfile.OrchestratorVersion)
+	orchestratorTypeVersions := map[string]bool{
+		fmt.Sprintf("%s:%s", uc.DataModel.Properties.OrchestratorProfile.OrchestratorType,
+			uc.DataModel.Properties.OrchestratorProfile.OrchestratorVersion): true,
+	}
+	if uc.Checkpoint != nil {
+		// nodes replaced before the upgrade was interrupted are tagged with the target version
+		orchestratorTypeVersions[fmt.Sprintf("%s:%s", uc.DataModel.Properties.OrchestratorProfile.OrchestratorType,
+			uc.Checkpoint.TargetVersion)] = true
+	}
 
+	currentVMFound := false
+	// the list spans all the result pages, other VMs of the resource group may be untagged
 	for _, vm := range *vmListResult.Value {
-		if *(*vm.Tags)["orchestrator"] == orchestratorTypeVersion {
-			if strings.Contains(*(vm.Name), "k8s-master-") {
-				log.Infoln(fmt.Sprintf("Master VM name: %s", *vm.Name))
-				// TODO: *vm.Tags["resourceNameSuffix"] ==  Read VM NAME SUFFIX from temp parameter
-				*uc.MasterVMs = append(*uc.MasterVMs, vm)
+		if vm.Tags != nil && orchestratorTypeVersions[tagValue(*vm.Tags, "orchestrator")] {
+			if uc.Checkpoint != nil && *vm.Name == uc.Checkpoint.CurrentVM {
+				currentVMFound = true
 			}
-			// TODO: Add logic to separate out VMs in various agent pookls
-			// TODO: This logic won't work for Windows agents
-			if strings.Contains(*(vm.Name), "k8s-agentpool") {
-				log.Infoln(fmt.Sprintf("Agent VM name: %s", *vm.Name))
-				// TODO: *vm.Tags["resourceNameSuffix"] ==  Read VM NAME SUFFIX from temp parameter
-				*uc.AgentVMs = append(*uc.AgentVMs, vm)
+			clusterVM, ok := IdentifyClusterVM(uc.DataModel.Properties, vm)
+			if !ok {
+				uc.logger().Infoln(fmt.Sprintf("Skipping VM of another cluster: %s", *vm.Name))
+				continue
 			}
+			uc.logger().Infoln(fmt.Sprintf("VM name: %s, pool: %s", *vm.Name, clusterVM.PoolName))
+			uc.addVM(clusterVM, vm)
 		}
 	}
 
+	if uc.Checkpoint != nil && uc.Checkpoint.CurrentVM != "" && !currentVMFound {
+		// the upgrade was interrupted after the VM was deleted, it only needs to be recreated
+		currentVM := uc.Checkpoint.CurrentVM
+		uc.logger().Infoln(fmt.Sprintf("Resuming the upgrade of deleted VM: %s", currentVM))
+		if uc.Checkpoint.CurrentStep == DeleteNodeStep {
+			uc.Checkpoint.CurrentStep = CreateNodeStep
+		}
+		vm := compute.VirtualMachine{Name: &currentVM}
+		clusterVM, ok := IdentifyClusterVM(uc.DataModel.Properties, vm)
+		if !ok {
+			return fmt.Errorf("VM %s of the upgrade checkpoint does not belong to the cluster", currentVM)
+		}
+		uc.addVM(clusterVM, vm)
+	}
+
 	return nil
 }
+
+func (uc *UpgradeCluster) logger() *logrus.Entry {
+	if uc.Logger == nil {
+		return logrus.NewEntry(logrus.StandardLogger())
+	}
+	return uc.Logger
+}
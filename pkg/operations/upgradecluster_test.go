@@ -0,0 +1,259 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/acs-engine/pkg/acsengine"
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/armhelpers"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/satori/go.uuid"
+)
+
+const testAPIModel = `{
+  "apiVersion": "vlabs",
+  "properties": {
+    "orchestratorProfile": {
+      "orchestratorType": "Kubernetes",
+      "orchestratorVersion": "1.5.3"
+    },
+    "masterProfile": {
+      "count": 1,
+      "dnsPrefix": "testupgrade",
+      "vmSize": "Standard_D2_v2"
+    },
+    "agentPoolProfiles": [
+      {
+        "name": "agentpool1",
+        "count": 2,
+        "vmSize": "Standard_D2_v2",
+        "availabilityProfile": "AvailabilitySet"
+      }
+    ],
+    "linuxProfile": {
+      "adminUsername": "azureuser",
+      "ssh": {
+        "publicKeys": [
+          {
+            "keyData": "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC8kvPPHEjgzpJwK0nBb0JR0nq4Db4pYh55GV+8xYLdrHNcpRkwvACAEQBDCW8B9hDn1h5TZmFKyJ6nKzhC4jrMmnmUD7VvO7JwR0HqH5hOa1SFyzNkxRGKkbvbTQb2EaSZIrPJqs0j6D4+4vo2x3cE6e9ePuKYEtSv9zqTxnJz6sO1xiFpdZ2OkQ2JkJxlfrECv+VWFEPt87FhAMI9LCd1LSahsf/IoyrtpdOwqnC2tKeRa2mqPbnAfzkFnaU8FUGCjGUHaO7rN0pJXRq9z1ldUPKu1AM5r37XI3b6Vc/gOGCX11+ZQ7H3vhcmbdO4ELI5FFQHQ2dbLqoPqZoMHmOb azureuser@test"
+          }
+        ]
+      }
+    },
+    "servicePrincipalProfile": {
+      "servicePrincipalClientID": "00000000-0000-0000-0000-000000000000",
+      "servicePrincipalClientSecret": "secret"
+    }
+  }
+}`
+
+// recreateVMs is an OnDeploy hook creating, at the target version, the VMs an upgrade template deploys
+func recreateVMs(cs *api.ContainerService, version string) func(*armhelpers.FakeResourceGroup, *armhelpers.FakeDeployment) error {
+	tags := map[string]*string{"orchestrator": to.StringPtr(fmt.Sprintf("Kubernetes:%s", version))}
+	return func(rg *armhelpers.FakeResourceGroup, deployment *armhelpers.FakeDeployment) error {
+		variables := deployment.Template["variables"].(map[string]interface{})
+		for _, resource := range deployment.Template["resources"].([]interface{}) {
+			resourceMap := resource.(map[string]interface{})
+			if resourceMap["type"] != "Microsoft.Compute/virtualMachines" {
+				continue
+			}
+			name := resourceMap["name"].(string)
+			var vmName string
+			switch {
+			case strings.Contains(name, "masterVMNamePrefix"):
+				vmName = fmt.Sprintf("%s%v", acsengine.GetK8sMasterVMNamePrefix(cs.Properties), variables["masterOffset"])
+			case strings.Contains(name, "agentpool1VMNamePrefix"):
+				offset := deployment.Parameters["agentpool1Offset"].(map[string]interface{})["value"]
+				vmName = fmt.Sprintf("%s%v", acsengine.GetK8sAgentVMNamePrefix(cs.Properties, 0), offset)
+			default:
+				return fmt.Errorf("unexpected VM resource %s", name)
+			}
+			rg.VirtualMachines[vmName] = newTestVM(vmName, tags, false)
+		}
+		return nil
+	}
+}
+
+func TestUpgradeCluster(t *testing.T) {
+	// the master upgrade writes its template to the _output directory
+	dir, err := ioutil.TempDir("", "acs-engine-upgrade")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+	os.Chdir(dir)
+
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ucs := &api.UpgradeContainerService{
+		OrchestratorProfile: &api.OrchestratorProfile{
+			OrchestratorType:    api.Kubernetes,
+			OrchestratorVersion: "1.6.2",
+		},
+	}
+
+	client := armhelpers.NewFakeACSEngineClient()
+	tags := map[string]*string{"orchestrator": to.StringPtr("Kubernetes:1.5.3")}
+	vmNames := []string{
+		acsengine.GetK8sMasterVMNamePrefix(cs.Properties) + "0",
+		acsengine.GetK8sAgentVMNamePrefix(cs.Properties, 0) + "0",
+		acsengine.GetK8sAgentVMNamePrefix(cs.Properties, 0) + "1",
+	}
+	for _, vmName := range vmNames {
+		client.AddVirtualMachine(testResourceGroup, newTestVM(vmName, tags, false))
+	}
+	client.OnDeploy = recreateVMs(cs, "1.6.2")
+
+	uc := UpgradeCluster{Client: client}
+	if err := uc.UpgradeCluster(context.Background(), uuid.NewV4(), testResourceGroup, cs, ucs); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rg := client.ResourceGroups[testResourceGroup]
+	if len(rg.Deployments) != len(vmNames) {
+		t.Fatalf("expected %d deployments, got %d", len(vmNames), len(rg.Deployments))
+	}
+	for _, vmName := range vmNames {
+		vm, ok := rg.VirtualMachines[vmName]
+		if !ok {
+			t.Fatalf("expected VM %s to be recreated", vmName)
+		}
+		if *(*vm.Tags)["orchestrator"] != "Kubernetes:1.6.2" {
+			t.Fatalf("expected VM %s to be upgraded, is %s", vmName, *(*vm.Tags)["orchestrator"])
+		}
+	}
+
+	deletions := []string{}
+	for _, call := range client.Calls {
+		if call.Method == "DeleteVirtualMachine" {
+			deletions = append(deletions, call.Args[1])
+		}
+	}
+	if strings.Join(deletions, ",") != strings.Join(vmNames, ",") {
+		t.Fatalf("expected the VMs to be replaced in order %v, got %v", vmNames, deletions)
+	}
+}
+
+func TestUpgradeClusterDeploymentFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "acs-engine-upgrade")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+	os.Chdir(dir)
+
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ucs := &api.UpgradeContainerService{
+		OrchestratorProfile: &api.OrchestratorProfile{
+			OrchestratorType:    api.Kubernetes,
+			OrchestratorVersion: "1.6.2",
+		},
+	}
+
+	client := armhelpers.NewFakeACSEngineClient()
+	masterName := acsengine.GetK8sMasterVMNamePrefix(cs.Properties) + "0"
+	client.AddVirtualMachine(testResourceGroup, newTestVM(masterName, map[string]*string{"orchestrator": to.StringPtr("Kubernetes:1.5.3")}, false))
+	client.OnDeploy = func(*armhelpers.FakeResourceGroup, *armhelpers.FakeDeployment) error {
+		return fmt.Errorf("deployment failed")
+	}
+
+	// the failure is returned to the caller, the process is not exited
+	uc := UpgradeCluster{Client: client, Logger: testLogger}
+	err = uc.UpgradeCluster(context.Background(), uuid.NewV4(), testResourceGroup, cs, ucs)
+	nodeErr, ok := err.(*UpgradeNodeError)
+	if !ok {
+		t.Fatalf("expected an UpgradeNodeError, got: %v", err)
+	}
+	if nodeErr.VMName != masterName || nodeErr.Step != CreateNodeStep {
+		t.Fatalf("expected the creation of %s to fail, got: %s", masterName, nodeErr)
+	}
+}
+
+func TestUpgradeClusterCanceled(t *testing.T) {
+	dir, err := ioutil.TempDir("", "acs-engine-upgrade")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	cwd, _ := os.Getwd()
+	defer os.Chdir(cwd)
+	os.Chdir(dir)
+
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	ucs := &api.UpgradeContainerService{
+		OrchestratorProfile: &api.OrchestratorProfile{
+			OrchestratorType:    api.Kubernetes,
+			OrchestratorVersion: "1.6.2",
+		},
+	}
+
+	client := armhelpers.NewFakeACSEngineClient()
+	masterName := acsengine.GetK8sMasterVMNamePrefix(cs.Properties) + "0"
+	client.AddVirtualMachine(testResourceGroup, newTestVM(masterName, map[string]*string{"orchestrator": to.StringPtr("Kubernetes:1.5.3")}, false))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// no step is started once the context is done
+	uc := UpgradeCluster{Client: client, Logger: testLogger}
+	err = uc.UpgradeCluster(ctx, uuid.NewV4(), testResourceGroup, cs, ucs)
+	nodeErr, ok := err.(*UpgradeNodeError)
+	if !ok || nodeErr.Err != context.Canceled || nodeErr.Step != DeleteNodeStep {
+		t.Fatalf("expected the upgrade to be canceled before deleting %s, got: %v", masterName, err)
+	}
+	if _, ok := client.ResourceGroups[testResourceGroup].VirtualMachines[masterName]; !ok {
+		t.Fatalf("expected VM %s not to be deleted", masterName)
+	}
+}
+
+func TestGetUpgradeWorkFlow(t *testing.T) {
+	cs, err := api.LoadContainerService([]byte(testAPIModel), "vlabs", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, test := range []struct {
+		orchestratorType api.OrchestratorType
+		version          api.OrchestratorVersion
+		supported        bool
+	}{
+		{api.Kubernetes, api.Kubernetes160, true},
+		{api.Kubernetes, api.Kubernetes162, true},
+		{api.Kubernetes, api.Kubernetes153, false},
+		{api.DCOS, api.Kubernetes162, false},
+	} {
+		uc := &UpgradeCluster{
+			ClusterTopology: ClusterTopology{DataModel: cs},
+			UpgradeModel: &api.UpgradeContainerService{
+				OrchestratorProfile: &api.OrchestratorProfile{
+					OrchestratorType:    test.orchestratorType,
+					OrchestratorVersion: test.version,
+				},
+			},
+		}
+		upgrader, err := getUpgradeWorkFlow(uc)
+		if test.supported && (err != nil || upgrader == nil) {
+			t.Errorf("expected an upgrader from Kubernetes 1.5.3 to %s %s, got error %v", test.orchestratorType, test.version, err)
+		}
+		if !test.supported && err == nil {
+			t.Errorf("expected no upgrader from Kubernetes 1.5.3 to %s %s", test.orchestratorType, test.version)
+		}
+	}
+}
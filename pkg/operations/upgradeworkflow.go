@@ -1,5 +1,13 @@
 package operations
 
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/api/common"
+)
+
 // UpgradeWorkFlow outlines various individual high level steps
 // that need to be run (one or more times) in the upgrade workflow.
 type UpgradeWorkFlow interface {
@@ -7,7 +15,8 @@ type UpgradeWorkFlow interface {
 
 	// upgrade masters
 	// upgrade agent nodes
-	RunUpgrade() error
+	// stops between two steps of a node upgrade once the context is done
+	RunUpgrade(context.Context) error
 
 	Validate() error
 }
@@ -18,11 +27,52 @@ type UpgradeNode interface {
 	// DeleteNode takes state/resources of the master/agent node from ListNodeResources
 	// backs up/preserves state as needed by a specific version of Kubernetes and then deletes
 	// the node
-	DeleteNode(*string) error
+	DeleteNode(context.Context, *string) error
 
-	// CreateNode creates a new master/agent node with the targeted version of Kubernetes
-	CreateNode(int) error
+	// CreateNode creates a new master/agent node with the targeted version of Kubernetes,
+	// at the specified offset of its master or agent pool
+	CreateNode(context.Context, int) error
 
 	// Validate will verify the that master/agent node has been upgraded as expected.
-	Validate() error
+	Validate(context.Context, *string) error
+}
+
+// upgradeWorkFlowFactory creates the UpgradeWorkFlow that drives a cluster towards the upgrade model
+type upgradeWorkFlowFactory func(uc *UpgradeCluster) UpgradeWorkFlow
+
+// upgradeWorkFlows maps each supported upgrade path to the UpgradeWorkFlow implementing it
+var upgradeWorkFlows = map[api.UpgradePath]upgradeWorkFlowFactory{}
+
+func init() {
+	// the generic Kubernetes upgrader implements every Kubernetes upgrade path
+	for _, path := range api.UpgradePaths {
+		if path.OrchestratorType == api.Kubernetes {
+			upgradeWorkFlows[path] = newKubernetesUpgrader
+		}
+	}
+}
+
+// getUpgradeWorkFlow returns the UpgradeWorkFlow upgrading the cluster from its current version to
+// the version of the upgrade model, or an error if there is no such upgrade path
+func getUpgradeWorkFlow(uc *UpgradeCluster) (UpgradeWorkFlow, error) {
+	orchestratorProfile := uc.DataModel.Properties.OrchestratorProfile
+	ucs := uc.UpgradeModel
+	targetVersion := ucs.OrchestratorProfile.OrchestratorVersion
+
+	if ucs.OrchestratorProfile.OrchestratorType != orchestratorProfile.OrchestratorType ||
+		!common.IsUpgradeSupportedForOrchestrator(string(orchestratorProfile.OrchestratorType)) {
+		return nil, fmt.Errorf("Upgrade is not supported for orchestrator: %s", ucs.OrchestratorProfile.OrchestratorType)
+	}
+
+	newWorkFlow, ok := upgradeWorkFlows[api.UpgradePath{
+		OrchestratorType: orchestratorProfile.OrchestratorType,
+		FromVersion:      orchestratorProfile.OrchestratorVersion,
+		ToVersion:        targetVersion,
+	}]
+	if !ok {
+		return nil, fmt.Errorf("Upgrade to %s %s is not supported from version: %s",
+			orchestratorProfile.OrchestratorType, targetVersion, orchestratorProfile.OrchestratorVersion)
+	}
+
+	return newWorkFlow(uc), nil
 }
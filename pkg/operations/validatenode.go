@@ -0,0 +1,102 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/api"
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+	"github.com/Sirupsen/logrus"
+)
+
+// DefaultNodeValidationTimeout is how long a replaced node has to become healthy before the upgrade is aborted
+const DefaultNodeValidationTimeout = 20 * time.Minute
+
+// validationPollInterval is how long to wait between two checks of the cluster state
+var validationPollInterval = 10 * time.Second
+
+// WaitForNodeReady waits until the node is Ready and its kubelet runs the expected version
+// (e.g. v1.6.2), or returns a TimeoutError once the timeout expires
+func WaitForNodeReady(ctx context.Context, logger *logrus.Entry, client kubernetes.Client, nodeName, kubeletVersion string, timeout time.Duration) error {
+	return waitFor(ctx, logger, timeout, fmt.Sprintf("node %s to be Ready with kubelet %s", nodeName, kubeletVersion), func() (bool, string) {
+		node, err := client.GetNode(nodeName)
+		if err != nil {
+			return false, err.Error()
+		}
+		if !node.IsReady() {
+			return false, "node is not Ready"
+		}
+		if node.Status.NodeInfo.KubeletVersion != kubeletVersion {
+			return false, fmt.Sprintf("node runs kubelet %s", node.Status.NodeInfo.KubeletVersion)
+		}
+		return true, ""
+	})
+}
+
+// WaitForEtcdHealthy waits until the master node has rejoined the etcd cluster of the masterCount masters and
+// every etcd member reports healthy, which they only do when the etcd cluster has quorum, or returns a
+// TimeoutError once the timeout expires. etcd is queried through the API server proxy to each master.
+func WaitForEtcdHealthy(ctx context.Context, logger *logrus.Entry, client kubernetes.Client, masterNodeName string, masterCount int, timeout time.Duration) error {
+	return waitFor(ctx, logger, timeout, fmt.Sprintf("master %s to rejoin a healthy etcd cluster", masterNodeName), func() (bool, string) {
+		members, err := client.ListEtcdMembers(masterNodeName)
+		if err != nil {
+			return false, err.Error()
+		}
+		if len(members) != masterCount {
+			return false, fmt.Sprintf("etcd has %d members, expected %d", len(members), masterCount)
+		}
+
+		rejoined := false
+		for _, member := range members {
+			if member.Name == "" || len(member.ClientURLs) == 0 {
+				return false, fmt.Sprintf("etcd member %s has not started", member.ID)
+			}
+			if member.Name == masterNodeName {
+				rejoined = true
+			}
+		}
+		if !rejoined {
+			return false, fmt.Sprintf("%s is not an etcd member", masterNodeName)
+		}
+
+		for _, member := range members {
+			healthy, err := client.IsEtcdHealthy(member.Name)
+			if err != nil {
+				return false, fmt.Sprintf("etcd member %s: %s", member.Name, err.Error())
+			}
+			if !healthy {
+				return false, fmt.Sprintf("etcd member %s is not healthy", member.Name)
+			}
+		}
+		return true, ""
+	})
+}
+
+// kubeletVersion returns the version a kubelet of the specified orchestrator version reports
+func kubeletVersion(version api.OrchestratorVersion) string {
+	return "v" + string(version)
+}
+
+// waitFor polls condition until it returns true, or returns a TimeoutError with the last
+// reason the condition gave once the timeout expires. It stops waiting when the context is done.
+func waitFor(ctx context.Context, logger *logrus.Entry, timeout time.Duration, description string, condition func() (bool, string)) error {
+	logger.Infoln(fmt.Sprintf("Waiting up to %s for %s", timeout, description))
+
+	deadline := time.Now().Add(timeout)
+	for {
+		done, reason := condition()
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return &TimeoutError{Description: description, Timeout: timeout, Reason: reason}
+		}
+		logger.Debugf("Still waiting for %s: %s", description, reason)
+		select {
+		case <-time.After(validationPollInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("canceled while waiting for %s: %s", description, ctx.Err().Error())
+		}
+	}
+}
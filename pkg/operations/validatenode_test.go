@@ -0,0 +1,208 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/acs-engine/pkg/kubernetes"
+)
+
+// fakeAPIServer serves the nodes and pods of a fake Kubernetes cluster, and proxies the etcd requests to
+// the masters to a fake etcd cluster of etcdMembers. A node becomes Ready after readyAfter requests for it,
+// and the first blockedEvictions evictions are rejected as a PodDisruptionBudget would.
+type fakeAPIServer struct {
+	sync.Mutex
+	kubeletVersion   string
+	readyAfter       int
+	nodeRequests     int
+	etcdMembers      []kubernetes.EtcdMember
+	etcdUnhealthy    map[string]bool
+	unschedulable    bool
+	pods             []kubernetes.Pod
+	blockedEvictions int
+	evictions        []string
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, fmt.Sprintf(":%d/proxy/v2/members", kubernetes.EtcdClientPort)):
+		list := struct {
+			Members []kubernetes.EtcdMember `json:"members"`
+		}{s.etcdMembers}
+		json.NewEncoder(w).Encode(list)
+	case strings.HasSuffix(r.URL.Path, fmt.Sprintf(":%d/proxy/health", kubernetes.EtcdClientPort)):
+		nodeName := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/"), ":")[0]
+		if s.etcdUnhealthy[nodeName] {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"health": "false"}`)
+			return
+		}
+		fmt.Fprint(w, `{"health": "true"}`)
+	case strings.HasPrefix(r.URL.Path, "/api/v1/nodes/") && r.Method == "PATCH":
+		patch := struct {
+			Spec struct {
+				Unschedulable bool `json:"unschedulable"`
+			} `json:"spec"`
+		}{}
+		json.NewDecoder(r.Body).Decode(&patch)
+		s.unschedulable = patch.Spec.Unschedulable
+		json.NewEncoder(w).Encode(s.node(strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/")))
+	case strings.HasPrefix(r.URL.Path, "/api/v1/nodes/"):
+		s.nodeRequests++
+		json.NewEncoder(w).Encode(s.node(strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/")))
+	case r.URL.Path == "/api/v1/pods":
+		list := struct {
+			Items []kubernetes.Pod `json:"items"`
+		}{s.pods}
+		json.NewEncoder(w).Encode(list)
+	case strings.HasSuffix(r.URL.Path, "/eviction"):
+		if s.blockedEvictions > 0 {
+			s.blockedEvictions--
+			http.Error(w, "Cannot evict pod as it would violate the pod's disruption budget.", http.StatusTooManyRequests)
+			return
+		}
+		name := strings.Split(r.URL.Path, "/")[6]
+		for i, pod := range s.pods {
+			if pod.Metadata.Name == name {
+				s.pods = append(s.pods[:i], s.pods[i+1:]...)
+				s.evictions = append(s.evictions, name)
+				w.WriteHeader(http.StatusCreated)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	case strings.HasPrefix(r.URL.Path, "/api/v1/namespaces/"):
+		name := strings.Split(r.URL.Path, "/")[6]
+		for _, pod := range s.pods {
+			if pod.Metadata.Name == name {
+				json.NewEncoder(w).Encode(pod)
+				return
+			}
+		}
+		http.NotFound(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *fakeAPIServer) node(name string) kubernetes.Node {
+	node := kubernetes.Node{}
+	node.Metadata.Name = name
+	node.Spec.Unschedulable = s.unschedulable
+	node.Status.NodeInfo.KubeletVersion = s.kubeletVersion
+	status := "False"
+	if s.nodeRequests > s.readyAfter {
+		status = kubernetes.ConditionTrue
+	}
+	node.Status.Conditions = []kubernetes.NodeCondition{{Type: kubernetes.NodeReady, Status: status}}
+	return node
+}
+
+func newFakeKubernetesClient(t *testing.T, s *fakeAPIServer) (kubernetes.Client, func()) {
+	server := httptest.NewServer(s)
+	kubeConfig := fmt.Sprintf(`{"clusters":[{"cluster":{"server":%q}}]}`, server.URL)
+	client, err := kubernetes.NewClient(kubeConfig, time.Second)
+	if err != nil {
+		server.Close()
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return client, server.Close
+}
+
+func setValidationPollInterval(interval time.Duration) func() {
+	previous := validationPollInterval
+	validationPollInterval = interval
+	return func() { validationPollInterval = previous }
+}
+
+func TestWaitForNodeReady(t *testing.T) {
+	defer setValidationPollInterval(time.Millisecond)()
+
+	s := &fakeAPIServer{kubeletVersion: "v1.6.2", readyAfter: 2}
+	client, closeServer := newFakeKubernetesClient(t, s)
+	defer closeServer()
+
+	if err := WaitForNodeReady(context.Background(), testLogger, client, "k8s-master-22551669-0", "v1.6.2", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.nodeRequests != 3 {
+		t.Fatalf("expected the node to be polled 3 times, was polled %d times", s.nodeRequests)
+	}
+}
+
+func TestWaitForNodeReadyWrongVersion(t *testing.T) {
+	defer setValidationPollInterval(time.Millisecond)()
+
+	s := &fakeAPIServer{kubeletVersion: "v1.5.3"}
+	client, closeServer := newFakeKubernetesClient(t, s)
+	defer closeServer()
+
+	err := WaitForNodeReady(context.Background(), testLogger, client, "k8s-agentpool1-22551669-0", "v1.6.2", 50*time.Millisecond)
+	if !IsTimeout(err) {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "v1.5.3") {
+		t.Fatalf("expected the error to report the kubelet version, got: %s", err)
+	}
+}
+
+func TestWaitForEtcdHealthy(t *testing.T) {
+	defer setValidationPollInterval(time.Millisecond)()
+
+	s := &fakeAPIServer{etcdUnhealthy: map[string]bool{}}
+	for i := 0; i < 3; i++ {
+		name := fmt.Sprintf("k8s-master-22551669-%d", i)
+		s.etcdMembers = append(s.etcdMembers, kubernetes.EtcdMember{
+			ID:         fmt.Sprintf("%d", i),
+			Name:       name,
+			ClientURLs: []string{fmt.Sprintf("http://10.240.255.%d:2379", 5+i)},
+		})
+	}
+	client, closeServer := newFakeKubernetesClient(t, s)
+	defer closeServer()
+
+	if err := WaitForEtcdHealthy(context.Background(), testLogger, client, "k8s-master-22551669-2", 3, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	s.etcdUnhealthy["k8s-master-22551669-1"] = true
+	err := WaitForEtcdHealthy(context.Background(), testLogger, client, "k8s-master-22551669-2", 3, 50*time.Millisecond)
+	if !IsTimeout(err) {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "etcd member k8s-master-22551669-1") {
+		t.Fatalf("expected the error to report the unhealthy member, got: %s", err)
+	}
+	delete(s.etcdUnhealthy, "k8s-master-22551669-1")
+
+	// the replaced master was added back to the etcd cluster, but its etcd member has not started
+	s.etcdMembers[2].Name = ""
+	s.etcdMembers[2].ClientURLs = nil
+	err = WaitForEtcdHealthy(context.Background(), testLogger, client, "k8s-master-22551669-2", 3, 50*time.Millisecond)
+	if !IsTimeout(err) {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "etcd member 2 has not started") {
+		t.Fatalf("expected the error to report the member that has not started, got: %s", err)
+	}
+
+	// the replaced master is not a member of the etcd cluster
+	s.etcdMembers = s.etcdMembers[:2]
+	err = WaitForEtcdHealthy(context.Background(), testLogger, client, "k8s-master-22551669-2", 3, 50*time.Millisecond)
+	if !IsTimeout(err) {
+		t.Fatalf("expected a timeout error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "etcd has 2 members, expected 3") {
+		t.Fatalf("expected the error to report the missing member, got: %s", err)
+	}
+}